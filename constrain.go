@@ -14,14 +14,48 @@
 
 package arktools
 
+// Signed 有符号整数
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned 无符号整数
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer 整数，包括有符号和无符号整数
+// 只需要整数的场景（例如位运算、取模）应该使用这个约束
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float 浮点数
+// 需要处理 NaN 或者无穷大的场景应该使用这个约束
+type Float interface {
+	~float32 | ~float64
+}
+
+// Complex 复数
+type Complex interface {
+	~complex64 | ~complex128
+}
+
 // RealNumber 实数
 // 绝大多数情况下，你都应该用这个来表达数字的含义
+// uintptr 表示的是地址而不是数值，所以虽然属于 Unsigned，但是不属于 RealNumber
 type RealNumber interface {
-	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
-		~int | ~int8 | ~int16 | ~int32 | ~int64 |
-		~float32 | ~float64
+	Signed | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | Float
 }
 
+// Number 数字，包括实数和复数
+// 复数不支持 < 和 > 比较，所以只有加减乘除之类的运算才应该使用这个约束
 type Number interface {
-	RealNumber | ~complex64 | ~complex128
+	RealNumber | Complex
+}
+
+// Ordered 可以使用 <、<=、>、>= 比较的类型，和标准库的 cmp.Ordered 一致
+// 需要比较大小的场景应该使用这个约束
+type Ordered interface {
+	Integer | Float | ~string
 }
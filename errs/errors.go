@@ -0,0 +1,465 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errs 定义了 arktools 返回的所有错误
+// 每一种错误都有一个结构体类型、一个哨兵错误和一个错误码：
+// 使用 errors.Is(err, errs.ErrIndexOutOfRange) 判断错误的种类，
+// 使用 errors.As(err, &target) 获取错误中携带的字段，例如越界的下标，
+// 使用 CodeOf 获取稳定的错误码，使用 Message 按照指定的语言渲染错误信息。
+package errs
+
+import (
+	"errors"
+	"time"
+)
+
+// Code 是错误码，稳定且适合机器处理，不会随着语言变化
+type Code string
+
+const (
+	CodeEmptySlice         Code = "EMPTY_SLICE"
+	CodeIndexOutOfRange    Code = "INDEX_OUT_OF_RANGE"
+	CodeTooFewElements     Code = "TOO_FEW_ELEMENTS"
+	CodeInvalidQuantile    Code = "INVALID_QUANTILE"
+	CodeInvalidPercentile  Code = "INVALID_PERCENTILE"
+	CodeInvalidSize        Code = "INVALID_SIZE"
+	CodeOverflow           Code = "OVERFLOW"
+	CodeNaN                Code = "NAN"
+	CodeInvalidType        Code = "INVALID_TYPE"
+	CodeInvalidInterval    Code = "INVALID_INTERVAL"
+	CodeInvalidMaxInterval Code = "INVALID_MAX_INTERVAL"
+	CodeRetryExhausted     Code = "RETRY_EXHAUSTED"
+	CodeInvalidArgument    Code = "INVALID_ARGUMENT"
+	CodeDuplicateKey       Code = "DUPLICATE_KEY"
+	CodeLengthMismatch     Code = "LENGTH_MISMATCH"
+	CodeElementFailed      Code = "ELEMENT_FAILED"
+)
+
+// Error 是 arktools 中所有错误都实现的接口
+type Error interface {
+	error
+	// Code 返回错误码
+	Code() Code
+	// Args 返回渲染错误信息需要的参数，哨兵错误返回 nil
+	Args() []any
+}
+
+// CodeOf 返回 err 或者它包装的错误中 arktools 错误的错误码
+// 第二个返回值为 false 表示没有 arktools 的错误
+func CodeOf(err error) (Code, bool) {
+	var e Error
+	if !errors.As(err, &e) {
+		return "", false
+	}
+	return e.Code(), true
+}
+
+// sentinel 是哨兵错误，只用于 errors.Is 匹配错误的种类
+type sentinel struct {
+	code Code
+}
+
+func (s *sentinel) Error() string {
+	return render(CurrentLanguage(), s.code, nil)
+}
+
+func (s *sentinel) Code() Code {
+	return s.code
+}
+
+func (s *sentinel) Args() []any {
+	return nil
+}
+
+var (
+	// ErrEmptySlice 代表传入的切片为空（nil 或长度为 0）
+	ErrEmptySlice error = &sentinel{code: CodeEmptySlice}
+	// ErrIndexOutOfRange 用于匹配 *IndexOutOfRangeError
+	ErrIndexOutOfRange error = &sentinel{code: CodeIndexOutOfRange}
+	// ErrTooFewElements 用于匹配 *TooFewElementsError
+	ErrTooFewElements error = &sentinel{code: CodeTooFewElements}
+	// ErrInvalidQuantile 用于匹配 *InvalidQuantileError
+	ErrInvalidQuantile error = &sentinel{code: CodeInvalidQuantile}
+	// ErrInvalidPercentile 用于匹配 *InvalidPercentileError
+	ErrInvalidPercentile error = &sentinel{code: CodeInvalidPercentile}
+	// ErrInvalidSize 用于匹配 *InvalidSizeError
+	ErrInvalidSize error = &sentinel{code: CodeInvalidSize}
+	// ErrOverflow 用于匹配 *OverflowError
+	ErrOverflow error = &sentinel{code: CodeOverflow}
+	// ErrNaN 用于匹配 *NaNError
+	ErrNaN error = &sentinel{code: CodeNaN}
+	// ErrInvalidType 用于匹配 *InvalidTypeError
+	ErrInvalidType error = &sentinel{code: CodeInvalidType}
+	// ErrInvalidInterval 用于匹配 *InvalidIntervalError
+	ErrInvalidInterval error = &sentinel{code: CodeInvalidInterval}
+	// ErrInvalidMaxInterval 用于匹配 *InvalidMaxIntervalError
+	ErrInvalidMaxInterval error = &sentinel{code: CodeInvalidMaxInterval}
+	// ErrRetryExhausted 用于匹配 *RetryExhaustedError
+	ErrRetryExhausted error = &sentinel{code: CodeRetryExhausted}
+	// ErrInvalidArgument 用于匹配 *InvalidArgumentError
+	ErrInvalidArgument error = &sentinel{code: CodeInvalidArgument}
+	// ErrDuplicateKey 用于匹配 *DuplicateKeyError
+	ErrDuplicateKey error = &sentinel{code: CodeDuplicateKey}
+	// ErrLengthMismatch 用于匹配 *LengthMismatchError
+	ErrLengthMismatch error = &sentinel{code: CodeLengthMismatch}
+	// ErrElementFailed 用于匹配 *ElementError
+	ErrElementFailed error = &sentinel{code: CodeElementFailed}
+)
+
+// IndexOutOfRangeError 下标超出范围
+type IndexOutOfRangeError struct {
+	Length int
+	Index  int
+}
+
+func (e *IndexOutOfRangeError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *IndexOutOfRangeError) Code() Code {
+	return CodeIndexOutOfRange
+}
+
+func (e *IndexOutOfRangeError) Args() []any {
+	return []any{e.Length, e.Index}
+}
+
+func (e *IndexOutOfRangeError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
+
+// TooFewElementsError 元素数量不足
+type TooFewElementsError struct {
+	// Least 是至少需要的元素数量
+	Least int
+	// Got 是实际的元素数量
+	Got int
+}
+
+func (e *TooFewElementsError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *TooFewElementsError) Code() Code {
+	return CodeTooFewElements
+}
+
+func (e *TooFewElementsError) Args() []any {
+	return []any{e.Least, e.Got}
+}
+
+func (e *TooFewElementsError) Is(target error) bool {
+	return target == ErrTooFewElements
+}
+
+// InvalidQuantileError 分位数超出 [0, 1] 的范围
+type InvalidQuantileError struct {
+	Quantile float64
+}
+
+func (e *InvalidQuantileError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *InvalidQuantileError) Code() Code {
+	return CodeInvalidQuantile
+}
+
+func (e *InvalidQuantileError) Args() []any {
+	return []any{e.Quantile}
+}
+
+func (e *InvalidQuantileError) Is(target error) bool {
+	return target == ErrInvalidQuantile
+}
+
+// InvalidPercentileError 百分位数超出 [0, 100] 的范围
+type InvalidPercentileError struct {
+	Percentile float64
+}
+
+func (e *InvalidPercentileError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *InvalidPercentileError) Code() Code {
+	return CodeInvalidPercentile
+}
+
+func (e *InvalidPercentileError) Args() []any {
+	return []any{e.Percentile}
+}
+
+func (e *InvalidPercentileError) Is(target error) bool {
+	return target == ErrInvalidPercentile
+}
+
+// InvalidSizeError 大小（数量）不合法，例如分组的大小小于等于 0
+type InvalidSizeError struct {
+	Size int
+}
+
+func (e *InvalidSizeError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *InvalidSizeError) Code() Code {
+	return CodeInvalidSize
+}
+
+func (e *InvalidSizeError) Args() []any {
+	return []any{e.Size}
+}
+
+func (e *InvalidSizeError) Is(target error) bool {
+	return target == ErrInvalidSize
+}
+
+// OverflowError 数值计算溢出
+type OverflowError struct {
+	// Op 是运算符，例如 "+"、"-"、"*"
+	Op string
+	A  any
+	B  any
+}
+
+func (e *OverflowError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *OverflowError) Code() Code {
+	return CodeOverflow
+}
+
+func (e *OverflowError) Args() []any {
+	return []any{e.A, e.Op, e.B}
+}
+
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
+
+// NaNError 浮点数为 NaN
+type NaNError struct {
+	Index int
+}
+
+func (e *NaNError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *NaNError) Code() Code {
+	return CodeNaN
+}
+
+func (e *NaNError) Args() []any {
+	return []any{e.Index}
+}
+
+func (e *NaNError) Is(target error) bool {
+	return target == ErrNaN
+}
+
+// InvalidTypeError 类型转换失败
+type InvalidTypeError struct {
+	Want string
+	Got  any
+}
+
+func (e *InvalidTypeError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *InvalidTypeError) Code() Code {
+	return CodeInvalidType
+}
+
+func (e *InvalidTypeError) Args() []any {
+	return []any{e.Want, e.Got}
+}
+
+func (e *InvalidTypeError) Is(target error) bool {
+	return target == ErrInvalidType
+}
+
+// InvalidIntervalError 间隔时间不合法
+type InvalidIntervalError struct {
+	Interval time.Duration
+}
+
+func (e *InvalidIntervalError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *InvalidIntervalError) Code() Code {
+	return CodeInvalidInterval
+}
+
+func (e *InvalidIntervalError) Args() []any {
+	return []any{e.Interval}
+}
+
+func (e *InvalidIntervalError) Is(target error) bool {
+	return target == ErrInvalidInterval
+}
+
+// InvalidMaxIntervalError 最大重试间隔小于初始重试间隔
+type InvalidMaxIntervalError struct {
+	MaxInterval     time.Duration
+	InitialInterval time.Duration
+}
+
+func (e *InvalidMaxIntervalError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *InvalidMaxIntervalError) Code() Code {
+	return CodeInvalidMaxInterval
+}
+
+func (e *InvalidMaxIntervalError) Args() []any {
+	return []any{e.MaxInterval, e.InitialInterval}
+}
+
+func (e *InvalidMaxIntervalError) Is(target error) bool {
+	return target == ErrInvalidMaxInterval
+}
+
+// RetryExhaustedError 超过最大重试次数
+// 可以通过 errors.Is / errors.As 继续匹配业务在任意一次尝试中返回的 error
+type RetryExhaustedError struct {
+	LastErr error
+	// Errors 是尝试返回的 error，按照尝试的顺序排列，最后一个就是 LastErr
+	// 尝试次数过多的时候，retry.Do 只保留最早的若干次以及最后一次
+	// 为空的时候表示只记录了 LastErr
+	Errors []error
+}
+
+func (e *RetryExhaustedError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *RetryExhaustedError) Code() Code {
+	return CodeRetryExhausted
+}
+
+func (e *RetryExhaustedError) Args() []any {
+	return []any{e.LastErr}
+}
+
+func (e *RetryExhaustedError) Is(target error) bool {
+	return target == ErrRetryExhausted
+}
+
+func (e *RetryExhaustedError) Unwrap() []error {
+	if len(e.Errors) == 0 {
+		return []error{e.LastErr}
+	}
+	return e.Errors
+}
+
+// InvalidArgumentError 参数不合法，用于没有专门错误类型的参数校验
+type InvalidArgumentError struct {
+	// Name 是参数的名字
+	Name  string
+	Value any
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *InvalidArgumentError) Code() Code {
+	return CodeInvalidArgument
+}
+
+func (e *InvalidArgumentError) Args() []any {
+	return []any{e.Name, e.Value}
+}
+
+func (e *InvalidArgumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// DuplicateKeyError 构造 map 时出现了重复的 key
+type DuplicateKeyError struct {
+	Key any
+	// Index 是第二次出现该 key 的元素的下标
+	Index int
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *DuplicateKeyError) Code() Code {
+	return CodeDuplicateKey
+}
+
+func (e *DuplicateKeyError) Args() []any {
+	return []any{e.Key, e.Index}
+}
+
+func (e *DuplicateKeyError) Is(target error) bool {
+	return target == ErrDuplicateKey
+}
+
+// LengthMismatchError 要求长度一致的多个切片长度不同
+type LengthMismatchError struct {
+	Left  int
+	Right int
+}
+
+func (e *LengthMismatchError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *LengthMismatchError) Code() Code {
+	return CodeLengthMismatch
+}
+
+func (e *LengthMismatchError) Args() []any {
+	return []any{e.Left, e.Right}
+}
+
+func (e *LengthMismatchError) Is(target error) bool {
+	return target == ErrLengthMismatch
+}
+
+// ElementError 处理切片中某一个元素的时候失败了
+type ElementError struct {
+	// Index 是失败的元素的下标
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return render(CurrentLanguage(), e.Code(), e.Args())
+}
+
+func (e *ElementError) Code() Code {
+	return CodeElementFailed
+}
+
+func (e *ElementError) Args() []any {
+	return []any{e.Index, e.Err}
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ElementError) Is(target error) bool {
+	return target == ErrElementFailed
+}
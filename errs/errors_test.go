@@ -0,0 +1,221 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		sentinel  error
+		wantMsg   string
+		wantEnMsg string
+		wantCode  Code
+	}{
+		{
+			name:      "index out of range",
+			err:       &IndexOutOfRangeError{Length: 2, Index: 3},
+			sentinel:  ErrIndexOutOfRange,
+			wantMsg:   "arktools: 下标超出范围，长度 2, 下标 3",
+			wantEnMsg: "arktools: index out of range, length 2, index 3",
+			wantCode:  CodeIndexOutOfRange,
+		},
+		{
+			name:      "too few elements",
+			err:       &TooFewElementsError{Least: 2, Got: 1},
+			sentinel:  ErrTooFewElements,
+			wantMsg:   "arktools: 元素数量不足，至少需要 2 个, 实际 1 个",
+			wantEnMsg: "arktools: too few elements, need at least 2, got 1",
+			wantCode:  CodeTooFewElements,
+		},
+		{
+			name:      "invalid quantile",
+			err:       &InvalidQuantileError{Quantile: 1.5},
+			sentinel:  ErrInvalidQuantile,
+			wantMsg:   "arktools: 无效的分位数 1.5, 预期值应在 [0, 1] 之间",
+			wantEnMsg: "arktools: invalid quantile 1.5, want a value in [0, 1]",
+			wantCode:  CodeInvalidQuantile,
+		},
+		{
+			name:      "invalid percentile",
+			err:       &InvalidPercentileError{Percentile: 150},
+			sentinel:  ErrInvalidPercentile,
+			wantMsg:   "arktools: 无效的百分位数 150, 预期值应在 [0, 100] 之间",
+			wantEnMsg: "arktools: invalid percentile 150, want a value in [0, 100]",
+			wantCode:  CodeInvalidPercentile,
+		},
+		{
+			name:      "invalid size",
+			err:       &InvalidSizeError{Size: 0},
+			sentinel:  ErrInvalidSize,
+			wantMsg:   "arktools: 无效的大小 0, 预期值应大于 0",
+			wantEnMsg: "arktools: invalid size 0, want a value greater than 0",
+			wantCode:  CodeInvalidSize,
+		},
+		{
+			name:      "overflow",
+			err:       &OverflowError{Op: "+", A: int8(127), B: int8(1)},
+			sentinel:  ErrOverflow,
+			wantMsg:   "arktools: 计算溢出，127 + 1 超出了类型的表示范围",
+			wantEnMsg: "arktools: overflow, 127 + 1 is out of the range of the type",
+			wantCode:  CodeOverflow,
+		},
+		{
+			name:      "NaN",
+			err:       &NaNError{Index: 1},
+			sentinel:  ErrNaN,
+			wantMsg:   "arktools: 下标 1 处的值为 NaN",
+			wantEnMsg: "arktools: value at index 1 is NaN",
+			wantCode:  CodeNaN,
+		},
+		{
+			name:      "invalid type",
+			err:       &InvalidTypeError{Want: "int", Got: "abc"},
+			sentinel:  ErrInvalidType,
+			wantMsg:   `arktools: 类型转换失败，预期类型:int, 实际值:"abc"`,
+			wantEnMsg: `arktools: invalid type, want int, got "abc"`,
+			wantCode:  CodeInvalidType,
+		},
+		{
+			name:      "invalid interval",
+			err:       &InvalidIntervalError{Interval: -1},
+			sentinel:  ErrInvalidInterval,
+			wantMsg:   "arktools: 无效的间隔时间 -1ns, 预期值应大于 0",
+			wantEnMsg: "arktools: invalid interval -1ns, want a value greater than 0",
+			wantCode:  CodeInvalidInterval,
+		},
+		{
+			name:      "invalid max interval",
+			err:       &InvalidMaxIntervalError{MaxInterval: time.Second, InitialInterval: time.Minute},
+			sentinel:  ErrInvalidMaxInterval,
+			wantMsg:   "arktools: 最大重试间隔的时间 [1s] 应大于等于初始重试的间隔时间 [1m0s]",
+			wantEnMsg: "arktools: max interval [1s] should be greater than or equal to initial interval [1m0s]",
+			wantCode:  CodeInvalidMaxInterval,
+		},
+		{
+			name:      "retry exhausted",
+			err:       &RetryExhaustedError{LastErr: errors.New("timeout")},
+			sentinel:  ErrRetryExhausted,
+			wantMsg:   "arktools: 超过最大重试次数，业务返回的最后一个 error timeout",
+			wantEnMsg: "arktools: retry exhausted, the last error is timeout",
+			wantCode:  CodeRetryExhausted,
+		},
+		{
+			name:      "invalid argument",
+			err:       &InvalidArgumentError{Name: "multiplier", Value: 0.5},
+			sentinel:  ErrInvalidArgument,
+			wantMsg:   "arktools: 无效的参数 multiplier: 0.5",
+			wantEnMsg: "arktools: invalid argument multiplier: 0.5",
+			wantCode:  CodeInvalidArgument,
+		},
+		{
+			name:      "duplicate key",
+			err:       &DuplicateKeyError{Key: "a", Index: 2},
+			sentinel:  ErrDuplicateKey,
+			wantMsg:   "arktools: 重复的 key a, 下标 2",
+			wantEnMsg: "arktools: duplicate key a at index 2",
+			wantCode:  CodeDuplicateKey,
+		},
+		{
+			name:      "length mismatch",
+			err:       &LengthMismatchError{Left: 3, Right: 2},
+			sentinel:  ErrLengthMismatch,
+			wantMsg:   "arktools: 长度不一致，左边 3, 右边 2",
+			wantEnMsg: "arktools: length mismatch, left 3, right 2",
+			wantCode:  CodeLengthMismatch,
+		},
+		{
+			name:      "element failed",
+			err:       &ElementError{Index: 1, Err: errors.New("invalid syntax")},
+			sentinel:  ErrElementFailed,
+			wantMsg:   "arktools: 处理下标 1 处的元素失败: invalid syntax",
+			wantEnMsg: "arktools: element at index 1 failed: invalid syntax",
+			wantCode:  CodeElementFailed,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualError(t, tc.err, tc.wantMsg)
+			assert.ErrorIs(t, tc.err, tc.sentinel)
+			// 包装之后依旧能够匹配
+			assert.ErrorIs(t, fmt.Errorf("wrapped: %w", tc.err), tc.sentinel)
+			// 不会匹配其它的哨兵错误
+			assert.NotErrorIs(t, tc.err, ErrEmptySlice)
+
+			assert.Equal(t, tc.wantEnMsg, Message(tc.err, LanguageEn))
+			assert.Equal(t, tc.wantMsg, Message(tc.err, LanguageZh))
+			code, ok := CodeOf(fmt.Errorf("wrapped: %w", tc.err))
+			assert.True(t, ok)
+			assert.Equal(t, tc.wantCode, code)
+			// 哨兵错误和结构体错误的错误码一致
+			assert.Equal(t, tc.wantCode, tc.sentinel.(Error).Code())
+		})
+	}
+}
+
+func TestErrorsAs(t *testing.T) {
+	var err error = fmt.Errorf("wrapped: %w", &IndexOutOfRangeError{Length: 2, Index: 3})
+	var target *IndexOutOfRangeError
+	assert.True(t, errors.As(err, &target))
+	assert.Equal(t, 2, target.Length)
+	assert.Equal(t, 3, target.Index)
+
+	var typeErr *InvalidTypeError
+	assert.False(t, errors.As(err, &typeErr))
+}
+
+func TestRetryExhaustedError_Unwrap(t *testing.T) {
+	bizErr := errors.New("timeout")
+	err := error(&RetryExhaustedError{LastErr: bizErr})
+	assert.ErrorIs(t, err, bizErr)
+	assert.ErrorIs(t, err, ErrRetryExhausted)
+
+	firstErr := &IndexOutOfRangeError{Length: 1, Index: 2}
+	err = &RetryExhaustedError{LastErr: bizErr, Errors: []error{firstErr, bizErr}}
+	assert.ErrorIs(t, err, bizErr)
+	var target *IndexOutOfRangeError
+	assert.True(t, errors.As(err, &target))
+	assert.Equal(t, firstErr, target)
+}
+
+func TestElementError_Unwrap(t *testing.T) {
+	bizErr := errors.New("invalid syntax")
+	err := fmt.Errorf("wrapped: %w", &ElementError{Index: 2, Err: bizErr})
+	assert.ErrorIs(t, err, bizErr)
+	assert.ErrorIs(t, err, ErrElementFailed)
+	var target *ElementError
+	assert.True(t, errors.As(err, &target))
+	assert.Equal(t, 2, target.Index)
+}
+
+func ExampleIndexOutOfRangeError() {
+	var err error = &IndexOutOfRangeError{Length: 2, Index: 3}
+	var target *IndexOutOfRangeError
+	if errors.As(err, &target) {
+		fmt.Println(target.Length, target.Index)
+	}
+	fmt.Println(errors.Is(err, ErrIndexOutOfRange))
+	// Output:
+	// 2 3
+	// true
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+)
+
+// Language 是错误信息的语言
+type Language string
+
+const (
+	LanguageZh Language = "zh"
+	LanguageEn Language = "en"
+)
+
+// messagePrefix 是所有错误信息的前缀，便于在日志中识别
+const messagePrefix = "arktools: "
+
+// Localizer 将错误码和参数渲染为指定语言的错误信息
+// 哨兵错误的 args 为 nil，这时候应该返回错误种类的简短描述
+// 第二个返回值为 false 表示不支持该语言或者错误码，此时会回退到内置的 zh 和 en 翻译
+type Localizer interface {
+	Localize(lang Language, code Code, args []any) (string, bool)
+}
+
+// Bundle 是一种语言的全部翻译
+// Titles 是错误种类的简短描述，用于哨兵错误；
+// Templates 是完整错误信息的 fmt 模板，占位符的顺序和 Error.Args 一致
+type Bundle struct {
+	Titles    map[Code]string
+	Templates map[Code]string
+}
+
+// BundleLocalizer 是基于 Bundle 的 Localizer，可以用来支持新的语言或者覆盖内置的翻译
+type BundleLocalizer map[Language]Bundle
+
+func (b BundleLocalizer) Localize(lang Language, code Code, args []any) (string, bool) {
+	bundle, ok := b[lang]
+	if !ok {
+		return "", false
+	}
+	if args == nil {
+		title, ok := bundle.Titles[code]
+		return title, ok
+	}
+	tpl, ok := bundle.Templates[code]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf(tpl, args...), true
+}
+
+var builtinLocalizer = BundleLocalizer{
+	LanguageZh: {
+		Titles: map[Code]string{
+			CodeEmptySlice:         "切片为空",
+			CodeIndexOutOfRange:    "下标超出范围",
+			CodeTooFewElements:     "元素数量不足",
+			CodeInvalidQuantile:    "无效的分位数",
+			CodeInvalidPercentile:  "无效的百分位数",
+			CodeInvalidSize:        "无效的大小",
+			CodeOverflow:           "计算溢出",
+			CodeNaN:                "值为 NaN",
+			CodeInvalidType:        "类型转换失败",
+			CodeInvalidInterval:    "无效的间隔时间",
+			CodeInvalidMaxInterval: "无效的最大重试间隔",
+			CodeRetryExhausted:     "超过最大重试次数",
+			CodeInvalidArgument:    "无效的参数",
+			CodeDuplicateKey:       "重复的 key",
+			CodeLengthMismatch:     "长度不一致",
+			CodeElementFailed:      "处理元素失败",
+		},
+		Templates: map[Code]string{
+			CodeIndexOutOfRange:    "下标超出范围，长度 %d, 下标 %d",
+			CodeTooFewElements:     "元素数量不足，至少需要 %d 个, 实际 %d 个",
+			CodeInvalidQuantile:    "无效的分位数 %v, 预期值应在 [0, 1] 之间",
+			CodeInvalidPercentile:  "无效的百分位数 %v, 预期值应在 [0, 100] 之间",
+			CodeInvalidSize:        "无效的大小 %d, 预期值应大于 0",
+			CodeOverflow:           "计算溢出，%v %s %v 超出了类型的表示范围",
+			CodeNaN:                "下标 %d 处的值为 NaN",
+			CodeInvalidType:        "类型转换失败，预期类型:%s, 实际值:%#v",
+			CodeInvalidInterval:    "无效的间隔时间 %v, 预期值应大于 0",
+			CodeInvalidMaxInterval: "最大重试间隔的时间 [%v] 应大于等于初始重试的间隔时间 [%v]",
+			CodeRetryExhausted:     "超过最大重试次数，业务返回的最后一个 error %v",
+			CodeInvalidArgument:    "无效的参数 %s: %v",
+			CodeDuplicateKey:       "重复的 key %v, 下标 %d",
+			CodeLengthMismatch:     "长度不一致，左边 %d, 右边 %d",
+			CodeElementFailed:      "处理下标 %d 处的元素失败: %v",
+		},
+	},
+	LanguageEn: {
+		Titles: map[Code]string{
+			CodeEmptySlice:         "empty slice",
+			CodeIndexOutOfRange:    "index out of range",
+			CodeTooFewElements:     "too few elements",
+			CodeInvalidQuantile:    "invalid quantile",
+			CodeInvalidPercentile:  "invalid percentile",
+			CodeInvalidSize:        "invalid size",
+			CodeOverflow:           "overflow",
+			CodeNaN:                "value is NaN",
+			CodeInvalidType:        "invalid type",
+			CodeInvalidInterval:    "invalid interval",
+			CodeInvalidMaxInterval: "invalid max interval",
+			CodeRetryExhausted:     "retry exhausted",
+			CodeInvalidArgument:    "invalid argument",
+			CodeDuplicateKey:       "duplicate key",
+			CodeLengthMismatch:     "length mismatch",
+			CodeElementFailed:      "element failed",
+		},
+		Templates: map[Code]string{
+			CodeIndexOutOfRange:    "index out of range, length %d, index %d",
+			CodeTooFewElements:     "too few elements, need at least %d, got %d",
+			CodeInvalidQuantile:    "invalid quantile %v, want a value in [0, 1]",
+			CodeInvalidPercentile:  "invalid percentile %v, want a value in [0, 100]",
+			CodeInvalidSize:        "invalid size %d, want a value greater than 0",
+			CodeOverflow:           "overflow, %v %s %v is out of the range of the type",
+			CodeNaN:                "value at index %d is NaN",
+			CodeInvalidType:        "invalid type, want %s, got %#v",
+			CodeInvalidInterval:    "invalid interval %v, want a value greater than 0",
+			CodeInvalidMaxInterval: "max interval [%v] should be greater than or equal to initial interval [%v]",
+			CodeRetryExhausted:     "retry exhausted, the last error is %v",
+			CodeInvalidArgument:    "invalid argument %s: %v",
+			CodeDuplicateKey:       "duplicate key %v at index %d",
+			CodeLengthMismatch:     "length mismatch, left %d, right %d",
+			CodeElementFailed:      "element at index %d failed: %v",
+		},
+	},
+}
+
+var (
+	language  atomic.Value
+	localizer atomic.Pointer[Localizer]
+)
+
+func init() {
+	language.Store(LanguageZh)
+}
+
+// SetLanguage 设置全局的错误信息语言，默认是 LanguageZh
+// Error() 方法总是使用全局的语言，需要按次指定语言的时候使用 Message
+func SetLanguage(lang Language) {
+	language.Store(lang)
+}
+
+// CurrentLanguage 返回全局的错误信息语言
+func CurrentLanguage() Language {
+	return language.Load().(Language)
+}
+
+// SetLocalizer 设置全局的自定义翻译，传入 nil 表示只使用内置的翻译
+// 自定义翻译不支持的语言或者错误码，会回退到内置的翻译
+func SetLocalizer(l Localizer) {
+	if l == nil {
+		localizer.Store(nil)
+		return
+	}
+	localizer.Store(&l)
+}
+
+// Message 使用 lang 渲染 err 中 arktools 错误的错误信息
+// 如果 err 是包装之后的错误，只会渲染其中的 arktools 错误，外层附加的信息会被忽略；
+// 如果 err 或者它包装的错误中没有 arktools 的错误，直接返回 err.Error()
+// 如果 err 为 nil，返回空字符串
+func Message(err error, lang Language) string {
+	if err == nil {
+		return ""
+	}
+	var e Error
+	if !errors.As(err, &e) {
+		return err.Error()
+	}
+	return render(lang, e.Code(), e.Args())
+}
+
+// render 渲染带有前缀的错误信息
+func render(lang Language, code Code, args []any) string {
+	return messagePrefix + localize(lang, code, args)
+}
+
+// localize 按照 自定义翻译 -> 内置翻译 -> 内置 zh 翻译 -> 错误码 的顺序渲染不带前缀的错误信息
+func localize(lang Language, code Code, args []any) string {
+	args = localizeArgs(lang, args)
+	if l := localizer.Load(); l != nil {
+		if msg, ok := (*l).Localize(lang, code, args); ok {
+			return msg
+		}
+	}
+	if msg, ok := builtinLocalizer.Localize(lang, code, args); ok {
+		return msg
+	}
+	if msg, ok := builtinLocalizer.Localize(LanguageZh, code, args); ok {
+		return msg
+	}
+	return string(code)
+}
+
+// localizeArgs 使用同样的 lang 渲染 args 中的 arktools 错误，并且去掉前缀
+// 否则 ElementError 之类包装了其它错误的错误，内层的错误信息会使用全局的语言，并且重复前缀
+// 不会修改 args 本身
+func localizeArgs(lang Language, args []any) []any {
+	var res []any
+	for i, arg := range args {
+		e, ok := arg.(Error)
+		if !ok {
+			continue
+		}
+		if res == nil {
+			res = slices.Clone(args)
+		}
+		res[i] = localize(lang, e.Code(), e.Args())
+	}
+	if res == nil {
+		return args
+	}
+	return res
+}
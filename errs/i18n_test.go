@@ -0,0 +1,149 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLanguage(t *testing.T) {
+	t.Cleanup(func() {
+		SetLanguage(LanguageZh)
+	})
+	err := &IndexOutOfRangeError{Length: 2, Index: 3}
+	assert.Equal(t, LanguageZh, CurrentLanguage())
+	assert.EqualError(t, err, "arktools: 下标超出范围，长度 2, 下标 3")
+	assert.EqualError(t, ErrEmptySlice, "arktools: 切片为空")
+
+	SetLanguage(LanguageEn)
+	assert.Equal(t, LanguageEn, CurrentLanguage())
+	assert.EqualError(t, err, "arktools: index out of range, length 2, index 3")
+	assert.EqualError(t, ErrEmptySlice, "arktools: empty slice")
+	// 内层的错误使用同样的语言，并且不会重复前缀
+	assert.EqualError(t, &ElementError{Index: 0, Err: err},
+		"arktools: element at index 0 failed: index out of range, length 2, index 3")
+
+	// 不支持的语言回退到 zh
+	SetLanguage("fr")
+	assert.EqualError(t, err, "arktools: 下标超出范围，长度 2, 下标 3")
+}
+
+func TestMessage(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		lang Language
+		want string
+	}{
+		{
+			name: "sentinel",
+			err:  ErrIndexOutOfRange,
+			lang: LanguageEn,
+			want: "arktools: index out of range",
+		},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("query: %w", &NaNError{Index: 2}),
+			lang: LanguageEn,
+			want: "arktools: value at index 2 is NaN",
+		},
+		{
+			name: "nested element error",
+			err:  fmt.Errorf("map: %w", &ElementError{Index: 0, Err: &IndexOutOfRangeError{Length: 0, Index: 5}}),
+			lang: LanguageEn,
+			want: "arktools: element at index 0 failed: index out of range, length 0, index 5",
+		},
+		{
+			name: "nested retry exhausted error",
+			err:  &RetryExhaustedError{LastErr: ErrEmptySlice},
+			lang: LanguageEn,
+			want: "arktools: retry exhausted, the last error is empty slice",
+		},
+		{
+			name: "nested not arktools error",
+			err:  &ElementError{Index: 1, Err: errors.New("invalid syntax")},
+			lang: LanguageEn,
+			want: "arktools: element at index 1 failed: invalid syntax",
+		},
+		{
+			name: "not arktools error",
+			err:  errors.New("timeout"),
+			lang: LanguageEn,
+			want: "timeout",
+		},
+		{
+			name: "nil",
+			lang: LanguageEn,
+			want: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Message(tc.err, tc.lang))
+		})
+	}
+
+	_, ok := CodeOf(errors.New("timeout"))
+	assert.False(t, ok)
+}
+
+func TestSetLocalizer(t *testing.T) {
+	t.Cleanup(func() {
+		SetLocalizer(nil)
+	})
+	SetLocalizer(BundleLocalizer{
+		"ja": {
+			Titles: map[Code]string{
+				CodeEmptySlice: "スライスが空です",
+			},
+			Templates: map[Code]string{
+				CodeIndexOutOfRange: "インデックスが範囲外です。長さ %d, インデックス %d",
+			},
+		},
+		LanguageEn: {
+			Templates: map[Code]string{
+				CodeNaN: "NaN found at %d",
+			},
+		},
+	})
+	assert.Equal(t, "arktools: スライスが空です", Message(ErrEmptySlice, "ja"))
+	assert.Equal(t, "arktools: インデックスが範囲外です。長さ 2, インデックス 3",
+		Message(&IndexOutOfRangeError{Length: 2, Index: 3}, "ja"))
+	// 覆盖内置的翻译
+	assert.Equal(t, "arktools: NaN found at 1", Message(&NaNError{Index: 1}, LanguageEn))
+	// 自定义翻译中没有的错误码，回退到内置翻译
+	assert.Equal(t, "arktools: invalid size 0, want a value greater than 0",
+		Message(&InvalidSizeError{Size: 0}, LanguageEn))
+	assert.Equal(t, "arktools: 无效的大小 0, 预期值应大于 0", Message(&InvalidSizeError{Size: 0}, "ja"))
+
+	SetLocalizer(nil)
+	assert.Equal(t, "arktools: value at index 1 is NaN", Message(&NaNError{Index: 1}, LanguageEn))
+}
+
+func ExampleMessage() {
+	err := fmt.Errorf("load users: %w", &IndexOutOfRangeError{Length: 2, Index: 3})
+	code, _ := CodeOf(err)
+	fmt.Println(code)
+	fmt.Println(Message(err, LanguageEn))
+	fmt.Println(Message(err, LanguageZh))
+	// Output:
+	// INDEX_OUT_OF_RANGE
+	// arktools: index out of range, length 2, index 3
+	// arktools: 下标超出范围，长度 2, 下标 3
+}
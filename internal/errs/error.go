@@ -1,28 +1,89 @@
+// Package errs 提供创建错误的快捷方法，错误的类型定义在公开的 arktools/errs 包中
 package errs
 
 import (
-	"fmt"
 	"time"
+
+	arkerrs "github.com/hanleilei/arktools/errs"
 )
 
+// ErrEmptySlice 代表传入的切片为空（nil 或长度为 0）
+var ErrEmptySlice = arkerrs.ErrEmptySlice
+
 // NewErrIndexOutOfRange 创建一个代表下标超出范围的错误
 func NewErrIndexOutOfRange(length int, index int) error {
-	return fmt.Errorf("ekit: 下标超出范围，长度 %d, 下标 %d", length, index)
+	return &arkerrs.IndexOutOfRangeError{Length: length, Index: index}
+}
+
+// NewErrTooFewElements 创建一个代表元素数量不足的错误
+// least 是至少需要的元素数量，got 是实际的元素数量
+func NewErrTooFewElements(least int, got int) error {
+	return &arkerrs.TooFewElementsError{Least: least, Got: got}
+}
+
+// NewErrInvalidQuantile 创建一个代表分位数超出 [0, 1] 范围的错误
+func NewErrInvalidQuantile(q float64) error {
+	return &arkerrs.InvalidQuantileError{Quantile: q}
+}
+
+// NewErrInvalidPercentile 创建一个代表百分位数超出 [0, 100] 范围的错误
+func NewErrInvalidPercentile(p float64) error {
+	return &arkerrs.InvalidPercentileError{Percentile: p}
+}
+
+// NewErrInvalidSize 创建一个代表大小（数量）不合法的错误
+func NewErrInvalidSize(size int) error {
+	return &arkerrs.InvalidSizeError{Size: size}
+}
+
+// NewErrOverflow 创建一个代表数值计算溢出的错误
+// op 是运算符，例如 "+"、"-"、"*"
+func NewErrOverflow(op string, a, b any) error {
+	return &arkerrs.OverflowError{Op: op, A: a, B: b}
+}
+
+// NewErrNaN 创建一个代表 index 处的浮点数为 NaN 的错误
+func NewErrNaN(index int) error {
+	return &arkerrs.NaNError{Index: index}
 }
 
 // NewErrInvalidType 创建一个代表类型转换失败的错误
 func NewErrInvalidType(want string, got any) error {
-	return fmt.Errorf("ekit: 类型转换失败，预期类型:%s, 实际值:%#v", want, got)
+	return &arkerrs.InvalidTypeError{Want: want, Got: got}
 }
 
 func NewErrInvalidIntervalValue(interval time.Duration) error {
-	return fmt.Errorf("ekit: 无效的间隔时间 %d, 预期值应大于 0", interval)
+	return &arkerrs.InvalidIntervalError{Interval: interval}
 }
 
 func NewErrInvalidMaxIntervalValue(maxInterval, initialInterval time.Duration) error {
-	return fmt.Errorf("ekit: 最大重试间隔的时间 [%d] 应大于等于初始重试的间隔时间 [%d] ", maxInterval, initialInterval)
+	return &arkerrs.InvalidMaxIntervalError{MaxInterval: maxInterval, InitialInterval: initialInterval}
+}
+
+// NewErrRetryExhausted 创建一个代表超过最大重试次数的错误
+// attemptErrs 是每一次尝试返回的 error，可以不传，只记录 lastErr
+func NewErrRetryExhausted(lastErr error, attemptErrs ...error) error {
+	return &arkerrs.RetryExhaustedError{LastErr: lastErr, Errors: attemptErrs}
+}
+
+// NewErrInvalidArgument 创建一个代表参数不合法的错误
+// name 是参数的名字，value 是参数的值
+func NewErrInvalidArgument(name string, value any) error {
+	return &arkerrs.InvalidArgumentError{Name: name, Value: value}
+}
+
+// NewErrDuplicateKey 创建一个代表 key 重复的错误
+// index 是第二次出现该 key 的元素的下标
+func NewErrDuplicateKey(key any, index int) error {
+	return &arkerrs.DuplicateKeyError{Key: key, Index: index}
+}
+
+// NewErrLengthMismatch 创建一个代表两个切片长度不一致的错误
+func NewErrLengthMismatch(left int, right int) error {
+	return &arkerrs.LengthMismatchError{Left: left, Right: right}
 }
 
-func NewErrRetryExhausted(lastErr error) error {
-	return fmt.Errorf("ekit: 超过最大重试次数，业务返回的最后一个 error %w", lastErr)
+// NewErrElement 创建一个代表处理 index 处的元素失败的错误，err 是处理过程中返回的错误
+func NewErrElement(index int, err error) error {
+	return &arkerrs.ElementError{Index: index, Err: err}
 }
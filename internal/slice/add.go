@@ -38,3 +38,20 @@ func Add[T any](src []T, element T, index int) ([]T, error) {
 
 	return result, nil
 }
+
+// AddAll 在切片的指定位置插入多个元素，并返回新的切片
+// 插入之后 elements[0] 位于 index 处，elements 的顺序保持不变
+// 无论插入多少个元素，都只会分配一次内存，复制一次原有元素
+// 如果 index 超出范围（< 0 或 > len(src)），返回错误
+func AddAll[T any](src []T, elements []T, index int) ([]T, error) {
+	length := len(src)
+	if index < 0 || index > length {
+		return nil, errs.NewErrIndexOutOfRange(length, index)
+	}
+
+	result := make([]T, length+len(elements))
+	copy(result[:index], src[:index])
+	copy(result[index:], elements)
+	copy(result[index+len(elements):], src[index:])
+	return result, nil
+}
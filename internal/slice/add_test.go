@@ -212,3 +212,74 @@ func TestAddStruct(t *testing.T) {
 		})
 	}
 }
+
+func TestAddAll(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		addVals   []int
+		index     int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "index 0",
+			slice:     []int{123, 100},
+			addVals:   []int{1, 2},
+			index:     0,
+			wantSlice: []int{1, 2, 123, 100},
+		},
+		{
+			name:      "index middle",
+			slice:     []int{123, 124, 125},
+			addVals:   []int{1, 2, 3},
+			index:     1,
+			wantSlice: []int{123, 1, 2, 3, 124, 125},
+		},
+		{
+			name:      "append on last",
+			slice:     []int{123, 100},
+			addVals:   []int{1},
+			index:     2,
+			wantSlice: []int{123, 100, 1},
+		},
+		{
+			name:      "empty elements",
+			slice:     []int{123, 100},
+			addVals:   []int{},
+			index:     1,
+			wantSlice: []int{123, 100},
+		},
+		{
+			name:      "nil slice",
+			addVals:   []int{1, 2},
+			index:     0,
+			wantSlice: []int{1, 2},
+		},
+		{
+			name:    "index out of range",
+			slice:   []int{123, 100},
+			addVals: []int{1},
+			index:   3,
+			wantErr: errs.NewErrIndexOutOfRange(2, 3),
+		},
+		{
+			name:    "index less than 0",
+			slice:   []int{123, 100},
+			addVals: []int{1},
+			index:   -1,
+			wantErr: errs.NewErrIndexOutOfRange(2, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := AddAll(tc.slice, tc.addVals, tc.index)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
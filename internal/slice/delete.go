@@ -32,3 +32,46 @@ func Delete[T any](src []T, index int) ([]T, T, error) {
 	src = src[:length-1]
 	return src, res, nil
 }
+
+// DeleteRange 删除 [from, to) 区间内的元素
+// 所有操作都会在原切片上进行，区间之后的元素只会往前移动一次
+// 如果 from 或者 to 超出范围，返回 IndexOutOfRange 错误；如果 from > to，返回 InvalidArgument 错误
+func DeleteRange[T any](src []T, from, to int) ([]T, error) {
+	length := len(src)
+	if from < 0 || from > length {
+		return nil, errs.NewErrIndexOutOfRange(length, from)
+	}
+	if to > length {
+		return nil, errs.NewErrIndexOutOfRange(length, to)
+	}
+	if to < from {
+		return nil, errs.NewErrInvalidArgument("to", to)
+	}
+	n := copy(src[from:], src[to:])
+	return src[:from+n], nil
+}
+
+// DeleteAll 删除 indexes 中所有下标对应的元素
+// indexes 不需要有序，重复的下标只会删除一次
+// 所有操作都会在原切片上进行，并且只会遍历一次原切片
+// 只要有任何一个下标超出范围，就返回错误，并且原切片不会被修改
+func DeleteAll[T any](src []T, indexes []int) ([]T, error) {
+	length := len(src)
+	deleted := make([]bool, length)
+	for _, index := range indexes {
+		if index < 0 || index >= length {
+			return nil, errs.NewErrIndexOutOfRange(length, index)
+		}
+		deleted[index] = true
+	}
+	// 记录被删除的元素位置，也称空缺的位置
+	emptyPos := 0
+	for idx := range src {
+		if deleted[idx] {
+			continue
+		}
+		src[emptyPos] = src[idx]
+		emptyPos++
+	}
+	return src[:emptyPos], nil
+}
@@ -204,3 +204,150 @@ func TestDeleteStruct(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteRange(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		from      int
+		to        int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "delete head",
+			slice:     []int{1, 2, 3, 4},
+			from:      0,
+			to:        2,
+			wantSlice: []int{3, 4},
+		},
+		{
+			name:      "delete middle",
+			slice:     []int{1, 2, 3, 4},
+			from:      1,
+			to:        3,
+			wantSlice: []int{1, 4},
+		},
+		{
+			name:      "delete tail",
+			slice:     []int{1, 2, 3, 4},
+			from:      2,
+			to:        4,
+			wantSlice: []int{1, 2},
+		},
+		{
+			name:      "delete all",
+			slice:     []int{1, 2, 3, 4},
+			from:      0,
+			to:        4,
+			wantSlice: []int{},
+		},
+		{
+			name:      "empty range",
+			slice:     []int{1, 2, 3, 4},
+			from:      2,
+			to:        2,
+			wantSlice: []int{1, 2, 3, 4},
+		},
+		{
+			name:    "from less than 0",
+			slice:   []int{1, 2},
+			from:    -1,
+			to:      1,
+			wantErr: errs.NewErrIndexOutOfRange(2, -1),
+		},
+		{
+			name:    "from out of range",
+			slice:   []int{1, 2},
+			from:    3,
+			to:      3,
+			wantErr: errs.NewErrIndexOutOfRange(2, 3),
+		},
+		{
+			name:    "to out of range",
+			slice:   []int{1, 2},
+			from:    0,
+			to:      3,
+			wantErr: errs.NewErrIndexOutOfRange(2, 3),
+		},
+		{
+			name:    "to less than from",
+			slice:   []int{1, 2},
+			from:    1,
+			to:      0,
+			wantErr: errs.NewErrInvalidArgument("to", 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := DeleteRange(tc.slice, tc.from, tc.to)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		indexes   []int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "no index",
+			slice:     []int{1, 2, 3},
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "unordered indexes",
+			slice:     []int{1, 2, 3, 4, 5},
+			indexes:   []int{4, 0, 2},
+			wantSlice: []int{2, 4},
+		},
+		{
+			name:      "duplicate indexes",
+			slice:     []int{1, 2, 3},
+			indexes:   []int{1, 1},
+			wantSlice: []int{1, 3},
+		},
+		{
+			name:      "delete all",
+			slice:     []int{1, 2, 3},
+			indexes:   []int{0, 1, 2},
+			wantSlice: []int{},
+		},
+		{
+			name:      "index out of range",
+			slice:     []int{1, 2, 3},
+			indexes:   []int{0, 3},
+			wantSlice: []int{1, 2, 3},
+			wantErr:   errs.NewErrIndexOutOfRange(3, 3),
+		},
+		{
+			name:      "index less than 0",
+			slice:     []int{1, 2, 3},
+			indexes:   []int{-1},
+			wantSlice: []int{1, 2, 3},
+			wantErr:   errs.NewErrIndexOutOfRange(3, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := DeleteAll(tc.slice, tc.indexes)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				// 出错的时候原切片不会被修改
+				assert.Equal(t, tc.wantSlice, tc.slice)
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import "github.com/hanleilei/arktools/internal/errs"
+
+// Move 将 from 处的元素移动到 to 处，两者之间的元素依次挪动一个位置
+// 移动之后 src[to] 就是原来的 src[from]，其余元素的相对顺序保持不变
+// 所有操作都会在原切片上进行
+// 如果 from 或者 to 超出范围（< 0 或 >= len(src)），返回错误
+func Move[T any](src []T, from, to int) error {
+	length := len(src)
+	if from < 0 || from >= length {
+		return errs.NewErrIndexOutOfRange(length, from)
+	}
+	if to < 0 || to >= length {
+		return errs.NewErrIndexOutOfRange(length, to)
+	}
+	val := src[from]
+	if from < to {
+		// 中间的元素往前挪
+		copy(src[from:to], src[from+1:to+1])
+	} else {
+		// 中间的元素往后挪
+		copy(src[to+1:from+1], src[to:from])
+	}
+	src[to] = val
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMove(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		from      int
+		to        int
+		wantSlice []int
+		wantErr   error
+	}{
+		{
+			name:      "move forward",
+			slice:     []int{1, 2, 3, 4, 5},
+			from:      1,
+			to:        3,
+			wantSlice: []int{1, 3, 4, 2, 5},
+		},
+		{
+			name:      "move backward",
+			slice:     []int{1, 2, 3, 4, 5},
+			from:      3,
+			to:        1,
+			wantSlice: []int{1, 4, 2, 3, 5},
+		},
+		{
+			name:      "move head to tail",
+			slice:     []int{1, 2, 3},
+			from:      0,
+			to:        2,
+			wantSlice: []int{2, 3, 1},
+		},
+		{
+			name:      "move tail to head",
+			slice:     []int{1, 2, 3},
+			from:      2,
+			to:        0,
+			wantSlice: []int{3, 1, 2},
+		},
+		{
+			name:      "same position",
+			slice:     []int{1, 2, 3},
+			from:      1,
+			to:        1,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:    "from out of range",
+			slice:   []int{1, 2, 3},
+			from:    3,
+			to:      0,
+			wantErr: errs.NewErrIndexOutOfRange(3, 3),
+		},
+		{
+			name:    "to less than 0",
+			slice:   []int{1, 2, 3},
+			from:    0,
+			to:      -1,
+			wantErr: errs.NewErrIndexOutOfRange(3, -1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Move(tc.slice, tc.from, tc.to)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
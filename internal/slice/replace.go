@@ -0,0 +1,31 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import "github.com/hanleilei/arktools/internal/errs"
+
+// Replace 将 index 处的元素替换为 element，并返回被替换的元素
+// 所有操作都会在原切片上进行
+// 如果 index 超出范围（< 0 或 >= len(src)），返回错误
+func Replace[T any](src []T, index int, element T) (T, error) {
+	length := len(src)
+	if index < 0 || index >= length {
+		var zero T
+		return zero, errs.NewErrIndexOutOfRange(length, index)
+	}
+	old := src[index]
+	src[index] = element
+	return old, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplace(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		index     int
+		val       int
+		wantSlice []int
+		wantOld   int
+		wantErr   error
+	}{
+		{
+			name:      "index 0",
+			slice:     []int{1, 2, 3},
+			index:     0,
+			val:       10,
+			wantSlice: []int{10, 2, 3},
+			wantOld:   1,
+		},
+		{
+			name:      "index last",
+			slice:     []int{1, 2, 3},
+			index:     2,
+			val:       10,
+			wantSlice: []int{1, 2, 10},
+			wantOld:   3,
+		},
+		{
+			name:    "index out of range",
+			slice:   []int{1, 2, 3},
+			index:   3,
+			wantErr: errs.NewErrIndexOutOfRange(3, 3),
+		},
+		{
+			name:    "index less than 0",
+			slice:   []int{1, 2, 3},
+			index:   -1,
+			wantErr: errs.NewErrIndexOutOfRange(3, -1),
+		},
+		{
+			name:    "empty slice",
+			slice:   []int{},
+			index:   0,
+			wantErr: errs.NewErrIndexOutOfRange(0, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			old, err := Replace(tc.slice, tc.index, tc.val)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantOld, old)
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
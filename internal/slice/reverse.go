@@ -0,0 +1,42 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+// Reverse 原地反转 src
+func Reverse[T any](src []T) {
+	for i, j := 0, len(src)-1; i < j; i, j = i+1, j-1 {
+		src[i], src[j] = src[j], src[i]
+	}
+}
+
+// Rotate 原地将 src 向左循环移动 k 个位置，移动之后 src[0] 就是原来的 src[k]
+// k 为负数时向右循环移动，k 的绝对值大于 len(src) 时按照 len(src) 取模
+// 通过三次反转实现，不需要额外的内存
+func Rotate[T any](src []T, k int) {
+	length := len(src)
+	if length == 0 {
+		return
+	}
+	k %= length
+	if k < 0 {
+		k += length
+	}
+	if k == 0 {
+		return
+	}
+	Reverse(src[:k])
+	Reverse(src[k:])
+	Reverse(src)
+}
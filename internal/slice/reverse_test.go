@@ -0,0 +1,110 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		wantSlice []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "single",
+			slice:     []int{1},
+			wantSlice: []int{1},
+		},
+		{
+			name:      "even",
+			slice:     []int{1, 2, 3, 4},
+			wantSlice: []int{4, 3, 2, 1},
+		},
+		{
+			name:      "odd",
+			slice:     []int{1, 2, 3, 4, 5},
+			wantSlice: []int{5, 4, 3, 2, 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			Reverse(tc.slice)
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []int
+		k         int
+		wantSlice []int
+	}{
+		{
+			name: "nil",
+			k:    1,
+		},
+		{
+			name:      "zero",
+			slice:     []int{1, 2, 3},
+			k:         0,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "left",
+			slice:     []int{1, 2, 3, 4, 5},
+			k:         2,
+			wantSlice: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name:      "right",
+			slice:     []int{1, 2, 3, 4, 5},
+			k:         -2,
+			wantSlice: []int{4, 5, 1, 2, 3},
+		},
+		{
+			name:      "full circle",
+			slice:     []int{1, 2, 3},
+			k:         3,
+			wantSlice: []int{1, 2, 3},
+		},
+		{
+			name:      "larger than length",
+			slice:     []int{1, 2, 3},
+			k:         7,
+			wantSlice: []int{2, 3, 1},
+		},
+		{
+			name:      "negative larger than length",
+			slice:     []int{1, 2, 3},
+			k:         -4,
+			wantSlice: []int{3, 1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			Rotate(tc.slice, tc.k)
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
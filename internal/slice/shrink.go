@@ -14,39 +14,74 @@
 
 package slice
 
-// calCapacity 根据当前切片容量和长度，判断是否需要收缩，并返回新容量和是否变化。
-// 收缩策略：
-// 1. 小容量（<=64）不收缩。
-// 2. 大容量（>2048）且利用率低（容量/长度>=2）时按0.625收缩。
-// 3. 中等容量（<=2048）且利用率极低（容量/长度>=4）时减半收缩。
-// 4. 其余情况不收缩。
-func calCapacity(c, l int) (int, bool) {
-	if c <= 64 {
-		return c, false
-	}
-	if c > 2048 && (c/l >= 2) {
-		factor := 0.625
-		return int(float64(c) * factor), true
+// ShrinkPolicy 根据切片的容量 c 和长度 l 计算缩容之后的容量
+// 第二个返回值为 false 表示不需要缩容
+type ShrinkPolicy func(c, l int) (int, bool)
+
+// ShrinkThresholds 是基于阈值的缩容策略的参数
+// 容量不超过 MinCap 的切片永远不会缩容；
+// 容量超过 LargeCap 的切片被认为是大切片，容量达到长度的 LargeRatio 倍时，缩容为原容量的 LargeFactor 倍；
+// 其余切片容量达到长度的 SmallRatio 倍时，缩容为原容量的 SmallFactor 倍
+type ShrinkThresholds struct {
+	MinCap      int
+	LargeCap    int
+	LargeRatio  int
+	LargeFactor float64
+	SmallRatio  int
+	SmallFactor float64
+}
+
+// DefaultShrinkThresholds 返回默认的缩容阈值，也就是 Shrink 使用的阈值
+func DefaultShrinkThresholds() ShrinkThresholds {
+	return ShrinkThresholds{
+		MinCap:      64,
+		LargeCap:    2048,
+		LargeRatio:  2,
+		LargeFactor: 0.625,
+		SmallRatio:  4,
+		SmallFactor: 0.5,
 	}
-	if c <= 2048 && (c/l >= 4) {
-		return c / 2, true
+}
+
+// Policy 将阈值转化为 ShrinkPolicy
+func (t ShrinkThresholds) Policy() ShrinkPolicy {
+	return func(c, l int) (int, bool) {
+		if c <= t.MinCap {
+			return c, false
+		}
+		// 用乘法代替 c/l，避免 l 为 0 的时候除零
+		if c > t.LargeCap && c >= l*t.LargeRatio {
+			return int(float64(c) * t.LargeFactor), true
+		}
+		if c <= t.LargeCap && c >= l*t.SmallRatio {
+			return int(float64(c) * t.SmallFactor), true
+		}
+		return c, false
 	}
-	return c, false
 }
 
-// Shrink 收缩切片容量，避免内存浪费。
-// 仅在容量远大于实际长度时触发收缩，返回收缩后的新切片，否则返回原切片。
-// 收缩后数据顺序不变，原切片不受影响。
+var defaultShrinkPolicy = DefaultShrinkThresholds().Policy()
+
+// Shrink 使用默认的阈值对切片进行缩容
+// 如果不需要缩容，返回原切片；否则返回一个新的切片
 func Shrink[T any](src []T) []T {
-	if len(src) == 0 {
-		return src
+	return ShrinkFunc(src, defaultShrinkPolicy)
+}
+
+// ShrinkFunc 使用 policy 计算新的容量并对切片进行缩容
+// 如果 policy 为 nil，使用默认的阈值
+// 新的容量不会小于切片的长度
+// 空切片同样交给 policy 决定是否缩容，这样删除了所有元素的大切片也能释放内存
+func ShrinkFunc[T any](src []T, policy ShrinkPolicy) []T {
+	if policy == nil {
+		policy = defaultShrinkPolicy
 	}
 	c, l := cap(src), len(src)
-	n, changed := calCapacity(c, l)
-	if !changed {
+	n, changed := policy(c, l)
+	if !changed || n >= c {
 		return src
 	}
-	s := make([]T, 0, n)
+	s := make([]T, 0, max(n, l))
 	s = append(s, src...)
 	return s
 }
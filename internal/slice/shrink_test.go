@@ -63,3 +63,64 @@ func TestShrink_String(t *testing.T) {
 		})
 	}
 }
+
+func TestShrinkFunc(t *testing.T) {
+	halve := func(c, l int) (int, bool) {
+		return c / 2, true
+	}
+	custom := DefaultShrinkThresholds()
+	custom.MinCap = 1024
+	cases := []struct {
+		name    string
+		len     int
+		capIn   int
+		policy  ShrinkPolicy
+		wantCap int
+	}{
+		{"nil policy uses default", 100, 4096, nil, 2560},
+		{"custom func", 10, 100, halve, 50},
+		{"never shrink below length", 80, 100, halve, 80},
+		{"custom thresholds no shrink", 100, 1000, custom.Policy(), 1000},
+		{"custom thresholds shrink", 100, 2000, custom.Policy(), 1000},
+		{"policy not changed", 10, 100, func(c, l int) (int, bool) { return 1, false }, 100},
+		{"policy grows capacity", 10, 100, func(c, l int) (int, bool) { return 200, true }, 100},
+		{"empty slice", 0, 100, halve, 50},
+		{"empty slice with default policy", 0, 4096, nil, 2560},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := make([]int, c.len, c.capIn)
+			for i := range s {
+				s[i] = i
+			}
+			res := ShrinkFunc(s, c.policy)
+			assert.Equal(t, c.wantCap, cap(res))
+			assert.Equal(t, s, res)
+		})
+	}
+}
+
+func TestShrinkThresholds_Policy(t *testing.T) {
+	policy := DefaultShrinkThresholds().Policy()
+	cases := []struct {
+		name        string
+		c           int
+		l           int
+		wantCap     int
+		wantChanged bool
+	}{
+		{"small cap", 64, 1, 64, false},
+		{"large cap enough", 4096, 2048, 2560, true},
+		{"large cap not enough", 4096, 2049, 4096, false},
+		{"mid cap enough", 2048, 512, 1024, true},
+		{"mid cap not enough", 2048, 513, 2048, false},
+		{"zero length", 2048, 0, 1024, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n, changed := policy(c.c, c.l)
+			assert.Equal(t, c.wantCap, n)
+			assert.Equal(t, c.wantChanged, changed)
+		})
+	}
+}
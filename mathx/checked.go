@@ -0,0 +1,54 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mathx 提供标准库 math 之外的数值计算工具
+package mathx
+
+import (
+	"github.com/hanleilei/arktools"
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+// AddChecked 计算 a + b，如果结果超出了 T 的表示范围，返回溢出错误
+func AddChecked[T arktools.Integer](a, b T) (T, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, errs.NewErrOverflow("+", a, b)
+	}
+	return c, nil
+}
+
+// SubChecked 计算 a - b，如果结果超出了 T 的表示范围，返回溢出错误
+// 对于无符号整数，a < b 也会被认为是溢出
+func SubChecked[T arktools.Integer](a, b T) (T, error) {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return 0, errs.NewErrOverflow("-", a, b)
+	}
+	return c, nil
+}
+
+// MulChecked 计算 a * b，如果结果超出了 T 的表示范围，返回溢出错误
+func MulChecked[T arktools.Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	// 除法校验能发现绝大多数溢出，但是 MinInt * -1 的结果除以 -1 依旧是 MinInt，
+	// 所以还需要校验符号：a 和 b 同号的时候结果必须为正，异号的时候结果必须为负
+	if c/b != a || ((a < 0) == (b < 0)) != (c > 0) {
+		return 0, errs.NewErrOverflow("*", a, b)
+	}
+	return c, nil
+}
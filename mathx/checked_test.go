@@ -0,0 +1,138 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mathx
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddChecked(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a       int8
+		b       int8
+		want    int8
+		wantErr error
+	}{
+		{name: "normal", a: 100, b: 27, want: 127},
+		{name: "negative", a: -100, b: -28, want: -128},
+		{name: "positive overflow", a: 100, b: 28, wantErr: errs.NewErrOverflow("+", int8(100), int8(28))},
+		{name: "negative overflow", a: -100, b: -29, wantErr: errs.NewErrOverflow("+", int8(-100), int8(-29))},
+		{name: "mixed sign", a: 127, b: -128, want: -1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := AddChecked(tc.a, tc.b)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	testAddCheckedTypes[int](t, math.MaxInt)
+	testAddCheckedTypes[int16](t, math.MaxInt16)
+	testAddCheckedTypes[int32](t, math.MaxInt32)
+	testAddCheckedTypes[int64](t, math.MaxInt64)
+	testAddCheckedTypes[uint](t, math.MaxUint)
+	testAddCheckedTypes[uint8](t, math.MaxUint8)
+	testAddCheckedTypes[uint16](t, math.MaxUint16)
+	testAddCheckedTypes[uint32](t, math.MaxUint32)
+	testAddCheckedTypes[uint64](t, math.MaxUint64)
+}
+
+func TestSubChecked(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a       int8
+		b       int8
+		want    int8
+		wantErr error
+	}{
+		{name: "normal", a: -100, b: 28, want: -128},
+		{name: "negative b", a: 100, b: -27, want: 127},
+		{name: "negative overflow", a: -100, b: 29, wantErr: errs.NewErrOverflow("-", int8(-100), int8(29))},
+		{name: "positive overflow", a: 0, b: -128, wantErr: errs.NewErrOverflow("-", int8(0), int8(-128))},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := SubChecked(tc.a, tc.b)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	res, err := SubChecked[uint32](3, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), res)
+	_, err = SubChecked[uint32](2, 3)
+	assert.Equal(t, errs.NewErrOverflow("-", uint32(2), uint32(3)), err)
+}
+
+func TestMulChecked(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a       int8
+		b       int8
+		want    int8
+		wantErr error
+	}{
+		{name: "zero", a: 0, b: -128, want: 0},
+		{name: "normal", a: -16, b: 8, want: -128},
+		{name: "negative times negative", a: -11, b: -11, want: 121},
+		{name: "overflow", a: 16, b: 8, wantErr: errs.NewErrOverflow("*", int8(16), int8(8))},
+		{name: "negative overflow", a: -13, b: 10, wantErr: errs.NewErrOverflow("*", int8(-13), int8(10))},
+		{name: "min times -1", a: -128, b: -1, wantErr: errs.NewErrOverflow("*", int8(-128), int8(-1))},
+		{name: "-1 times min", a: -1, b: -128, wantErr: errs.NewErrOverflow("*", int8(-1), int8(-128))},
+		{name: "min times 1", a: -128, b: 1, want: -128},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := MulChecked(tc.a, tc.b)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	res, err := MulChecked[uint16](255, 257)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(65535), res)
+	_, err = MulChecked[uint16](256, 256)
+	assert.Equal(t, errs.NewErrOverflow("*", uint16(256), uint16(256)), err)
+	_, err = MulChecked[int64](math.MinInt64, -1)
+	assert.Error(t, err)
+}
+
+// testAddCheckedTypes 只是用来测试一下每一种整数类型的最大值加一都会溢出
+func testAddCheckedTypes[T int | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64](t *testing.T, maxVal T) {
+	res, err := AddChecked[T](maxVal-1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, maxVal, res)
+	_, err = AddChecked[T](maxVal, 1)
+	assert.Equal(t, errs.NewErrOverflow("+", maxVal, T(1)), err)
+}
+
+func ExampleMulChecked() {
+	_, err := MulChecked[int32](1<<16, 1<<16)
+	fmt.Println(err != nil)
+	res, err := MulChecked[int32](1<<15, 1<<15)
+	fmt.Println(res, err)
+	// Output:
+	// true
+	// 1073741824 <nil>
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"errors"
+	"time"
+)
+
+// Classifier 对业务返回的 error 进行分类
+// retryable 为 false 表示这是一个永久性的错误，应该立刻停止重试；
+// retryAfter 大于 0 表示服务端指定了下一次重试之前需要等待的时间，它会代替 Strategy 给出的等待时间
+type Classifier func(err error) (retryable bool, retryAfter time.Duration)
+
+// DefaultClassifier 是默认的分类方法
+// 被 Permanent 标记的错误不会重试；被 RetryAfter 标记的错误按照指定的时间等待；其余错误都按照 Strategy 重试
+// 自定义 Classifier 的时候，可以先调用 DefaultClassifier 以保留这两种标记的语义
+func DefaultClassifier(err error) (bool, time.Duration) {
+	var pe *permanentError
+	if errors.As(err, &pe) {
+		return false, 0
+	}
+	var re *retryAfterError
+	if errors.As(err, &re) {
+		return true, re.after
+	}
+	return true, 0
+}
+
+// Permanent 将 err 标记为永久性的错误，DefaultClassifier 遇到这种错误会立刻停止重试
+// 返回的 error 和 err 的错误信息一致，并且可以通过 errors.Is / errors.As 匹配 err
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// RetryAfter 将 err 标记为需要在 after 之后重试的错误，通常用于服务端返回了 Retry-After 的场景
+// 返回的 error 和 err 的错误信息一致，并且可以通过 errors.Is / errors.As 匹配 err
+func RetryAfter(err error, after time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	return &retryAfterError{err: err, after: after}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+type retryAfterError struct {
+	err   error
+	after time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryAfterError) Unwrap() error {
+	return e.err
+}
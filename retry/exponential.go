@@ -0,0 +1,104 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+var _ Strategy = (*ExponentialBackoffRetryStrategy)(nil)
+
+// ExponentialBackoffRetryStrategy 指数退避重试
+// 第 n 次重试（从 0 开始）之前等待 initialInterval * multiplier^n，但是不会超过 maxInterval；
+// 如果设置了 jitter，那么在这个基础上随机浮动 ±jitter 的比例，依旧不会超过 maxInterval
+type ExponentialBackoffRetryStrategy struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	// 最大重试次数，不包括第一次调用；如果是负数，表示无限重试
+	maxRetries int32
+	multiplier float64
+	jitter     float64
+	// 已经重试的次数
+	retries int32
+}
+
+// ExponentialBackoffOption 用于调整指数退避策略的可选参数
+type ExponentialBackoffOption func(s *ExponentialBackoffRetryStrategy)
+
+// WithMultiplier 设置每次重试间隔的增长倍数，默认是 2，必须大于等于 1
+func WithMultiplier(multiplier float64) ExponentialBackoffOption {
+	return func(s *ExponentialBackoffRetryStrategy) {
+		s.multiplier = multiplier
+	}
+}
+
+// WithJitter 设置重试间隔随机浮动的比例，默认是 0，也就是不浮动，取值范围是 [0, 1]
+// 多个客户端同时重试的时候，加上随机浮动可以避免它们在同一时刻打到服务端
+func WithJitter(jitter float64) ExponentialBackoffOption {
+	return func(s *ExponentialBackoffRetryStrategy) {
+		s.jitter = jitter
+	}
+}
+
+// NewExponentialBackoffRetryStrategy 创建指数退避重试策略
+// initialInterval 必须大于 0，maxInterval 必须大于等于 initialInterval；maxRetries 为负数表示无限重试
+func NewExponentialBackoffRetryStrategy(initialInterval, maxInterval time.Duration, maxRetries int32,
+	opts ...ExponentialBackoffOption) (*ExponentialBackoffRetryStrategy, error) {
+	if initialInterval <= 0 {
+		return nil, errs.NewErrInvalidIntervalValue(initialInterval)
+	}
+	if maxInterval < initialInterval {
+		return nil, errs.NewErrInvalidMaxIntervalValue(maxInterval, initialInterval)
+	}
+	s := &ExponentialBackoffRetryStrategy{
+		initialInterval: initialInterval,
+		maxInterval:     maxInterval,
+		maxRetries:      maxRetries,
+		multiplier:      2,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	// 用 !(x >= 1) 而不是 x < 1，这样 NaN 也会被拒绝
+	if !(s.multiplier >= 1) {
+		return nil, errs.NewErrInvalidArgument("multiplier", s.multiplier)
+	}
+	if !(s.jitter >= 0 && s.jitter <= 1) {
+		return nil, errs.NewErrInvalidArgument("jitter", s.jitter)
+	}
+	return s, nil
+}
+
+// Next 并发安全
+func (s *ExponentialBackoffRetryStrategy) Next() (time.Duration, bool) {
+	retries := atomic.AddInt32(&s.retries, 1)
+	if s.maxRetries >= 0 && retries > s.maxRetries {
+		return 0, false
+	}
+	interval := float64(s.initialInterval) * math.Pow(s.multiplier, float64(retries-1))
+	if s.jitter > 0 {
+		interval *= 1 + s.jitter*(2*rand.Float64()-1)
+	}
+	// 无限重试的时候 interval 会一直增长，甚至变成 +Inf，所以先和 maxInterval 比较再转换
+	if interval >= float64(s.maxInterval) {
+		return s.maxInterval, true
+	}
+	return time.Duration(interval), true
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	arkerrs "github.com/hanleilei/arktools/errs"
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewExponentialBackoffRetryStrategy(t *testing.T) {
+	testCases := []struct {
+		name            string
+		initialInterval time.Duration
+		maxInterval     time.Duration
+		maxRetries      int32
+		opts            []ExponentialBackoffOption
+		wantErr         error
+	}{
+		{
+			name:            "valid",
+			initialInterval: time.Second,
+			maxInterval:     time.Minute,
+			maxRetries:      3,
+		},
+		{
+			name:            "valid with options",
+			initialInterval: time.Second,
+			maxInterval:     time.Second,
+			maxRetries:      3,
+			opts:            []ExponentialBackoffOption{WithMultiplier(1), WithJitter(1)},
+		},
+		{
+			name:            "invalid initial interval",
+			initialInterval: 0,
+			maxInterval:     time.Minute,
+			wantErr:         errs.NewErrInvalidIntervalValue(0),
+		},
+		{
+			name:            "max interval less than initial interval",
+			initialInterval: time.Minute,
+			maxInterval:     time.Second,
+			wantErr:         errs.NewErrInvalidMaxIntervalValue(time.Second, time.Minute),
+		},
+		{
+			name:            "invalid multiplier",
+			initialInterval: time.Second,
+			maxInterval:     time.Minute,
+			opts:            []ExponentialBackoffOption{WithMultiplier(0.5)},
+			wantErr:         errs.NewErrInvalidArgument("multiplier", 0.5),
+		},
+		{
+			name:            "NaN multiplier",
+			initialInterval: time.Second,
+			maxInterval:     time.Minute,
+			opts:            []ExponentialBackoffOption{WithMultiplier(math.NaN())},
+			wantErr:         arkerrs.ErrInvalidArgument,
+		},
+		{
+			name:            "invalid jitter",
+			initialInterval: time.Second,
+			maxInterval:     time.Minute,
+			opts:            []ExponentialBackoffOption{WithJitter(1.5)},
+			wantErr:         errs.NewErrInvalidArgument("jitter", 1.5),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := NewExponentialBackoffRetryStrategy(tc.initialInterval, tc.maxInterval, tc.maxRetries, tc.opts...)
+			if tc.wantErr == arkerrs.ErrInvalidArgument {
+				// NaN 和 NaN 不相等，所以只判断错误的种类
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.NotNil(t, s)
+		})
+	}
+}
+
+func TestExponentialBackoffRetryStrategy_Next(t *testing.T) {
+	s, err := NewExponentialBackoffRetryStrategy(time.Second, 10*time.Second, 5)
+	assert.NoError(t, err)
+	wants := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second}
+	for _, want := range wants {
+		interval, ok := s.Next()
+		assert.True(t, ok)
+		assert.Equal(t, want, interval)
+	}
+	_, ok := s.Next()
+	assert.False(t, ok)
+
+	s, err = NewExponentialBackoffRetryStrategy(time.Second, time.Minute, 3, WithMultiplier(3))
+	assert.NoError(t, err)
+	wants = []time.Duration{time.Second, 3 * time.Second, 9 * time.Second}
+	for _, want := range wants {
+		interval, ok := s.Next()
+		assert.True(t, ok)
+		assert.Equal(t, want, interval)
+	}
+	_, ok = s.Next()
+	assert.False(t, ok)
+}
+
+func TestExponentialBackoffRetryStrategy_NextUnlimited(t *testing.T) {
+	s, err := NewExponentialBackoffRetryStrategy(time.Second, time.Minute, -1)
+	assert.NoError(t, err)
+	var interval time.Duration
+	var ok bool
+	// 足够多次之后 multiplier^n 会变成 +Inf，依旧要返回 maxInterval
+	for i := 0; i < 2000; i++ {
+		interval, ok = s.Next()
+		assert.True(t, ok)
+	}
+	assert.Equal(t, time.Minute, interval)
+}
+
+func TestExponentialBackoffRetryStrategy_Jitter(t *testing.T) {
+	s, err := NewExponentialBackoffRetryStrategy(time.Second, time.Minute, -1, WithJitter(0.5))
+	assert.NoError(t, err)
+	for i := 0; i < 6; i++ {
+		base := time.Second * time.Duration(1<<i)
+		interval, ok := s.Next()
+		assert.True(t, ok)
+		assert.GreaterOrEqual(t, interval, base/2)
+		assert.LessOrEqual(t, interval, min(base*3/2, time.Minute))
+	}
+}
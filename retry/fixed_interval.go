@@ -0,0 +1,54 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+var _ Strategy = (*FixedIntervalRetryStrategy)(nil)
+
+// FixedIntervalRetryStrategy 等间隔重试
+type FixedIntervalRetryStrategy struct {
+	// 最大重试次数，不包括第一次调用；如果是负数，表示无限重试
+	maxRetries int32
+	interval   time.Duration
+	// 已经重试的次数
+	retries int32
+}
+
+// NewFixedIntervalRetryStrategy 创建等间隔重试策略
+// interval 必须大于 0；maxRetries 为负数表示无限重试
+func NewFixedIntervalRetryStrategy(interval time.Duration, maxRetries int32) (*FixedIntervalRetryStrategy, error) {
+	if interval <= 0 {
+		return nil, errs.NewErrInvalidIntervalValue(interval)
+	}
+	return &FixedIntervalRetryStrategy{
+		maxRetries: maxRetries,
+		interval:   interval,
+	}, nil
+}
+
+// Next 并发安全
+func (s *FixedIntervalRetryStrategy) Next() (time.Duration, bool) {
+	retries := atomic.AddInt32(&s.retries, 1)
+	if s.maxRetries < 0 || retries <= s.maxRetries {
+		return s.interval, true
+	}
+	return 0, false
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFixedIntervalRetryStrategy(t *testing.T) {
+	testCases := []struct {
+		name       string
+		interval   time.Duration
+		maxRetries int32
+		want       *FixedIntervalRetryStrategy
+		wantErr    error
+	}{
+		{
+			name:       "valid",
+			interval:   time.Second,
+			maxRetries: 3,
+			want:       &FixedIntervalRetryStrategy{interval: time.Second, maxRetries: 3},
+		},
+		{
+			name:     "zero interval",
+			interval: 0,
+			wantErr:  errs.NewErrInvalidIntervalValue(0),
+		},
+		{
+			name:     "negative interval",
+			interval: -time.Second,
+			wantErr:  errs.NewErrInvalidIntervalValue(-time.Second),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := NewFixedIntervalRetryStrategy(tc.interval, tc.maxRetries)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, s)
+		})
+	}
+}
+
+func TestFixedIntervalRetryStrategy_Next(t *testing.T) {
+	s, err := NewFixedIntervalRetryStrategy(time.Second, 2)
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		interval, ok := s.Next()
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, interval)
+	}
+	_, ok := s.Next()
+	assert.False(t, ok)
+
+	s, err = NewFixedIntervalRetryStrategy(time.Second, 0)
+	assert.NoError(t, err)
+	_, ok = s.Next()
+	assert.False(t, ok)
+
+	// 无限重试
+	s, err = NewFixedIntervalRetryStrategy(time.Second, -1)
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		interval, ok := s.Next()
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, interval)
+	}
+}
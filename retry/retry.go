@@ -0,0 +1,134 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"time"
+
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+// maxRecordedErrs 是 Do 最多保留的尝试的 error 数量
+// 超出之后只保留前 maxRecordedErrs-1 次以及最后一次尝试的 error，避免无限重试的时候内存无限增长
+const maxRecordedErrs = 16
+
+// Option 用于调整 Do 的行为
+type Option func(o *options)
+
+type options struct {
+	classifier  Classifier
+	onRetry     func(attempt int, delay time.Duration, err error)
+	maxAttempts int
+	budget      time.Duration
+}
+
+// WithClassifier 设置错误分类方法，默认是 DefaultClassifier，传入 nil 的时候也使用 DefaultClassifier
+func WithClassifier(classifier Classifier) Option {
+	return func(o *options) {
+		o.classifier = classifier
+	}
+}
+
+// WithOnRetry 设置每次决定重试之后、等待之前的回调，一般用于打点或者记录日志
+// attempt 是刚刚失败的那一次尝试的序号，从 1 开始；delay 是接下来要等待的时间；err 是这一次尝试返回的 error
+func WithOnRetry(onRetry func(attempt int, delay time.Duration, err error)) Option {
+	return func(o *options) {
+		o.onRetry = onRetry
+	}
+}
+
+// WithMaxAttempts 设置最多尝试的次数，包括第一次调用
+// 和 Strategy 的最大重试次数同时生效，任何一个达到上限都会停止重试；小于等于 0 表示不限制
+func WithMaxAttempts(maxAttempts int) Option {
+	return func(o *options) {
+		o.maxAttempts = maxAttempts
+	}
+}
+
+// WithTimeBudget 设置重试的总时间预算，从第一次调用开始计算
+// 如果等待下一次重试会超出预算，就不再重试；小于等于 0 表示不限制
+func WithTimeBudget(budget time.Duration) Option {
+	return func(o *options) {
+		o.budget = budget
+	}
+}
+
+// Do 执行 fn，如果 fn 返回了 error，就按照 strategy 等待之后重试
+// fn 返回 nil 的时候立刻返回 nil；
+// 错误被分类为永久性错误的时候，立刻返回 fn 返回的 error；
+// 不允许继续重试的时候，返回 errs.ErrRetryExhausted，其中包装了尝试返回的 error，
+// 尝试次数很多的时候只保留最早的若干次以及最后一次；
+// 等待的过程中 ctx 被取消或者超时，返回 ctx.Err()
+func Do(ctx context.Context, strategy Strategy, fn func() error, opts ...Option) error {
+	o := options{
+		classifier: DefaultClassifier,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.classifier == nil {
+		o.classifier = DefaultClassifier
+	}
+	start := time.Now()
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	var attemptErrs []error
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if len(attemptErrs) < maxRecordedErrs {
+			attemptErrs = append(attemptErrs, err)
+		} else {
+			attemptErrs[maxRecordedErrs-1] = err
+		}
+		retryable, retryAfter := o.classifier(err)
+		if !retryable {
+			return err
+		}
+		if o.maxAttempts > 0 && attempt >= o.maxAttempts {
+			return errs.NewErrRetryExhausted(err, attemptErrs...)
+		}
+		interval, ok := strategy.Next()
+		if !ok {
+			return errs.NewErrRetryExhausted(err, attemptErrs...)
+		}
+		if retryAfter > 0 {
+			interval = retryAfter
+		}
+		if o.budget > 0 && time.Since(start)+interval > o.budget {
+			return errs.NewErrRetryExhausted(err, attemptErrs...)
+		}
+		if o.onRetry != nil {
+			o.onRetry(attempt, interval, err)
+		}
+		if timer == nil {
+			timer = time.NewTimer(interval)
+		} else {
+			timer.Reset(interval)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
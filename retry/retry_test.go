@@ -0,0 +1,290 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	arkerrs "github.com/hanleilei/arktools/errs"
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo(t *testing.T) {
+	bizErr := errors.New("biz error")
+	testCases := []struct {
+		name      string
+		ctx       func() (context.Context, context.CancelFunc)
+		fails     int
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "success at first time",
+			ctx:       func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			fails:     0,
+			wantCalls: 1,
+		},
+		{
+			name:      "success after retry",
+			ctx:       func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			fails:     2,
+			wantCalls: 3,
+		},
+		{
+			name:      "retry exhausted",
+			ctx:       func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			fails:     10,
+			wantCalls: 4,
+			wantErr:   errs.NewErrRetryExhausted(bizErr, bizErr, bizErr, bizErr, bizErr),
+		},
+		{
+			name: "context timeout",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 15*time.Millisecond)
+			},
+			fails:     10,
+			wantCalls: 2,
+			wantErr:   context.DeadlineExceeded,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := tc.ctx()
+			defer cancel()
+			strategy, err := NewFixedIntervalRetryStrategy(10*time.Millisecond, 3)
+			assert.NoError(t, err)
+			calls := 0
+			err = Do(ctx, strategy, func() error {
+				calls++
+				if calls <= tc.fails {
+					return bizErr
+				}
+				return nil
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantCalls, calls)
+		})
+	}
+}
+
+func TestDo_ErrorMatching(t *testing.T) {
+	bizErr := errors.New("biz error")
+	strategy, err := NewFixedIntervalRetryStrategy(time.Millisecond, 1)
+	assert.NoError(t, err)
+	err = Do(context.Background(), strategy, func() error {
+		return bizErr
+	})
+	assert.ErrorIs(t, err, arkerrs.ErrRetryExhausted)
+	assert.ErrorIs(t, err, bizErr)
+}
+
+func TestDo_Classifier(t *testing.T) {
+	bizErr := errors.New("biz error")
+	permanentErr := errors.New("permanent error")
+	strategy, err := NewFixedIntervalRetryStrategy(time.Millisecond, 10)
+	assert.NoError(t, err)
+	calls := 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		if calls < 3 {
+			return bizErr
+		}
+		return permanentErr
+	}, WithClassifier(func(err error) (bool, time.Duration) {
+		return err != permanentErr, 0
+	}))
+	assert.Equal(t, permanentErr, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestDo_NilClassifier(t *testing.T) {
+	bizErr := errors.New("biz error")
+	strategy, err := NewFixedIntervalRetryStrategy(time.Millisecond, 2)
+	assert.NoError(t, err)
+	calls := 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		return bizErr
+	}, WithClassifier(nil))
+	assert.Equal(t, errs.NewErrRetryExhausted(bizErr, bizErr, bizErr, bizErr), err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestDo_Permanent(t *testing.T) {
+	bizErr := errors.New("biz error")
+	strategy, err := NewFixedIntervalRetryStrategy(time.Millisecond, 10)
+	assert.NoError(t, err)
+	calls := 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		return Permanent(bizErr)
+	})
+	assert.ErrorIs(t, err, bizErr)
+	assert.NotErrorIs(t, err, arkerrs.ErrRetryExhausted)
+	assert.Equal(t, "biz error", err.Error())
+	assert.Equal(t, 1, calls)
+
+	assert.Nil(t, Permanent(nil))
+	assert.Nil(t, RetryAfter(nil, time.Second))
+}
+
+func TestDo_RetryAfter(t *testing.T) {
+	bizErr := errors.New("rate limited")
+	strategy, err := NewFixedIntervalRetryStrategy(time.Hour, 3)
+	assert.NoError(t, err)
+	calls := 0
+	var delays []time.Duration
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		if calls < 3 {
+			// 服务端指定的等待时间代替了策略的 1 小时
+			return RetryAfter(bizErr, time.Millisecond)
+		}
+		return nil
+	}, WithOnRetry(func(attempt int, delay time.Duration, err error) {
+		delays = append(delays, delay)
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{time.Millisecond, time.Millisecond}, delays)
+}
+
+func TestDo_OnRetry(t *testing.T) {
+	errs1, errs2 := errors.New("error 1"), errors.New("error 2")
+	strategy, err := NewExponentialBackoffRetryStrategy(time.Millisecond, time.Second, 2)
+	assert.NoError(t, err)
+	type record struct {
+		attempt int
+		delay   time.Duration
+		err     error
+	}
+	var records []record
+	calls := 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		if calls%2 == 1 {
+			return errs1
+		}
+		return errs2
+	}, WithOnRetry(func(attempt int, delay time.Duration, err error) {
+		records = append(records, record{attempt: attempt, delay: delay, err: err})
+	}))
+	assert.Equal(t, []record{
+		{attempt: 1, delay: time.Millisecond, err: errs1},
+		{attempt: 2, delay: 2 * time.Millisecond, err: errs2},
+	}, records)
+	// 每一次尝试的错误都会被保留
+	assert.Equal(t, errs.NewErrRetryExhausted(errs1, errs1, errs2, errs1), err)
+	var exhausted *arkerrs.RetryExhaustedError
+	assert.True(t, errors.As(err, &exhausted))
+	assert.Equal(t, []error{errs1, errs2, errs1}, exhausted.Errors)
+	assert.ErrorIs(t, err, errs2)
+}
+
+func TestDo_MaxAttempts(t *testing.T) {
+	bizErr := errors.New("biz error")
+	strategy, err := NewFixedIntervalRetryStrategy(time.Millisecond, -1)
+	assert.NoError(t, err)
+	calls := 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		return bizErr
+	}, WithMaxAttempts(3))
+	assert.ErrorIs(t, err, arkerrs.ErrRetryExhausted)
+	assert.Equal(t, 3, calls)
+}
+
+func TestDo_TimeBudget(t *testing.T) {
+	bizErr := errors.New("biz error")
+	// 等待下一次重试一定会超出预算，因此不会等待，直接返回
+	strategy, err := NewFixedIntervalRetryStrategy(time.Hour, -1)
+	assert.NoError(t, err)
+	calls := 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		return bizErr
+	}, WithTimeBudget(10*time.Second))
+	assert.Equal(t, errs.NewErrRetryExhausted(bizErr, bizErr), err)
+	assert.Equal(t, 1, calls)
+
+	// 预算充足的时候不影响重试
+	strategy, err = NewFixedIntervalRetryStrategy(time.Millisecond, -1)
+	assert.NoError(t, err)
+	calls = 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		return bizErr
+	}, WithTimeBudget(10*time.Second), WithMaxAttempts(3))
+	assert.ErrorIs(t, err, arkerrs.ErrRetryExhausted)
+	assert.Equal(t, 3, calls)
+}
+
+func TestDo_RecordedErrsBounded(t *testing.T) {
+	strategy, err := NewFixedIntervalRetryStrategy(time.Nanosecond, -1)
+	assert.NoError(t, err)
+	attempts := 100
+	calls := 0
+	err = Do(context.Background(), strategy, func() error {
+		calls++
+		return fmt.Errorf("error %d", calls)
+	}, WithMaxAttempts(attempts))
+	assert.Equal(t, attempts, calls)
+	var exhausted *arkerrs.RetryExhaustedError
+	assert.True(t, errors.As(err, &exhausted))
+	assert.Len(t, exhausted.Errors, maxRecordedErrs)
+	// 保留最早的若干次以及最后一次
+	assert.EqualError(t, exhausted.Errors[0], "error 1")
+	assert.EqualError(t, exhausted.Errors[maxRecordedErrs-2], fmt.Sprintf("error %d", maxRecordedErrs-1))
+	assert.EqualError(t, exhausted.Errors[maxRecordedErrs-1], "error 100")
+	assert.Equal(t, exhausted.LastErr, exhausted.Errors[maxRecordedErrs-1])
+}
+
+func ExampleDo() {
+	strategy, _ := NewExponentialBackoffRetryStrategy(time.Millisecond, 10*time.Millisecond, 3)
+	calls := 0
+	err := Do(context.Background(), strategy, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("temporary error")
+		}
+		return nil
+	})
+	fmt.Println(calls, err)
+	// Output:
+	// 3 <nil>
+}
+
+func ExampleWithOnRetry() {
+	strategy, _ := NewFixedIntervalRetryStrategy(time.Millisecond, 5)
+	calls := 0
+	err := Do(context.Background(), strategy, func() error {
+		calls++
+		if calls == 1 {
+			return errors.New("unavailable")
+		}
+		return Permanent(errors.New("invalid request"))
+	}, WithOnRetry(func(attempt int, delay time.Duration, err error) {
+		fmt.Println("retry", attempt, delay, err)
+	}))
+	fmt.Println(calls, err)
+	// Output:
+	// retry 1 1ms unavailable
+	// 2 invalid request
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry 提供重试策略以及按照策略执行重试的方法
+package retry
+
+import "time"
+
+// Strategy 是重试策略
+// 策略是有状态的，记录了已经重试的次数，因此每一次需要重试的业务调用都应该创建一个新的策略
+type Strategy interface {
+	// Next 返回下一次重试之前需要等待的时间
+	// 第二个返回值为 false 表示不应该继续重试
+	Next() (time.Duration, bool)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import "iter"
+
+// Collect 遍历 seq，并将所有元素收集到切片中
+// 即使 seq 中没有元素，也保证返回的是一个空切片而不是 nil
+func Collect[T any](seq iter.Seq[T]) []T {
+	res := make([]T, 0)
+	for s := range seq {
+		res = append(res, s)
+	}
+	return res
+}
+
+// ToMap 遍历 seq，将元素映射到 map[Key]Ele
+// 语义和 slice.ToMap 保持一致：出现重复的 key 时，后出现的元素会覆盖先出现的元素
+// 即使 seq 中没有元素，也保证返回的 map 是一个空 map 而不是 nil
+func ToMap[Ele any, Key comparable](seq iter.Seq[Ele], fn func(element Ele) Key) map[Key]Ele {
+	return ToMapV(seq, func(element Ele) (Key, Ele) {
+		return fn(element), element
+	})
+}
+
+// ToMapV 遍历 seq，将元素映射到 map[Key]Val
+// 语义和 slice.ToMapV 保持一致：出现重复的 key 时，后出现的值会覆盖先出现的值
+// 即使 seq 中没有元素，也保证返回的 map 是一个空 map 而不是 nil
+func ToMapV[Ele any, Key comparable, Val any](seq iter.Seq[Ele], fn func(element Ele) (Key, Val)) map[Key]Val {
+	resultMap := make(map[Key]Val)
+	for element := range seq {
+		k, v := fn(element)
+		resultMap[k] = v
+	}
+	return resultMap
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollect(t *testing.T) {
+	assert.Equal(t, []int{}, Collect(slices.Values[[]int](nil)))
+	assert.Equal(t, []int{1, 2, 3}, Collect(slices.Values([]int{1, 2, 3})))
+}
+
+func TestToMap(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []testutil.Person
+		want map[string]testutil.Person
+	}{
+		{
+			name: "src nil",
+			want: map[string]testutil.Person{},
+		},
+		{
+			name: "duplicate key keeps the last one",
+			src: []testutil.Person{
+				{Name: "Alice", Age: 30},
+				{Name: "Bob", Age: 25},
+				{Name: "Alice", Age: 31},
+			},
+			want: map[string]testutil.Person{
+				"Alice": {Name: "Alice", Age: 31},
+				"Bob":   {Name: "Bob", Age: 25},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ToMap(slices.Values(tt.src), func(p testutil.Person) string {
+				return p.Name
+			})
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestToMapV(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []testutil.Person
+		want map[string]int
+	}{
+		{
+			name: "src nil",
+			want: map[string]int{},
+		},
+		{
+			name: "duplicate key keeps the last one",
+			src: []testutil.Person{
+				{Name: "Alice", Age: 30},
+				{Name: "Bob", Age: 25},
+				{Name: "Alice", Age: 31},
+			},
+			want: map[string]int{"Alice": 31, "Bob": 25},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ToMapV(slices.Values(tt.src), func(p testutil.Person) (string, int) {
+				return p.Name, p.Age
+			})
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func ExampleToMapV() {
+	people := slices.Values([]testutil.Person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}})
+	adults := Filter(people, func(idx int, p testutil.Person) bool {
+		return p.Age >= 28
+	})
+	ages := ToMapV(adults, func(p testutil.Person) (string, int) {
+		return p.Name, p.Age
+	})
+	fmt.Println(ages)
+	// Output: map[Alice:30]
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import "iter"
+
+// Chain 将多个 seq 首尾相连，按照传入的顺序依次遍历
+func Chain[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for s := range seq {
+				if !yield(s) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Zip 将两个 seq 按位置一一配对
+// 任何一个 seq 遍历完毕就会停止，多余的元素会被忽略
+func Zip[A any, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate 为 seq 中的元素加上下标，下标从 0 开始
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		idx := 0
+		for s := range seq {
+			if !yield(idx, s) {
+				return
+			}
+			idx++
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name string
+		seqs []iter.Seq[int]
+		want []int
+	}{
+		{
+			name: "no seq",
+			want: []int{},
+		},
+		{
+			name: "empty seqs",
+			seqs: []iter.Seq[int]{slices.Values([]int{}), slices.Values[[]int](nil)},
+			want: []int{},
+		},
+		{
+			name: "multiple seqs",
+			seqs: []iter.Seq[int]{slices.Values([]int{1, 2}), slices.Values([]int{}), slices.Values([]int{3})},
+			want: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Collect(Chain(tt.seqs...)))
+		})
+	}
+
+	res := Collect(Take(Chain(slices.Values([]int{1}), naturals()), 3))
+	assert.Equal(t, []int{1, 0, 1}, res)
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []string
+		want []string
+	}{
+		{
+			name: "both nil",
+			want: []string{},
+		},
+		{
+			name: "same length",
+			a:    []int{1, 2},
+			b:    []string{"a", "b"},
+			want: []string{"1a", "2b"},
+		},
+		{
+			name: "a is shorter",
+			a:    []int{1},
+			b:    []string{"a", "b"},
+			want: []string{"1a"},
+		},
+		{
+			name: "b is shorter",
+			a:    []int{1, 2, 3},
+			b:    []string{"a", "b"},
+			want: []string{"1a", "2b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := make([]string, 0)
+			for a, b := range Zip(slices.Values(tt.a), slices.Values(tt.b)) {
+				res = append(res, fmt.Sprintf("%d%s", a, b))
+			}
+			assert.Equal(t, tt.want, res)
+		})
+	}
+
+	// 提前中断
+	res := make([]int, 0)
+	for a, b := range Zip(naturals(), naturals()) {
+		if a >= 2 {
+			break
+		}
+		res = append(res, a+b)
+	}
+	assert.Equal(t, []int{0, 2}, res)
+}
+
+func TestEnumerate(t *testing.T) {
+	res := make([]string, 0)
+	for idx, s := range Enumerate(slices.Values([]string{"a", "b", "c"})) {
+		res = append(res, fmt.Sprintf("%d%s", idx, s))
+	}
+	assert.Equal(t, []string{"0a", "1b", "2c"}, res)
+
+	for range Enumerate(slices.Values[[]string](nil)) {
+		t.Fatal("should not be called")
+	}
+}
+
+func ExampleZip() {
+	ids := slices.Values([]int{1, 2, 3})
+	names := slices.Values([]string{"Alice", "Bob"})
+	for id, name := range Zip(ids, names) {
+		fmt.Println(id, name)
+	}
+	// Output:
+	// 1 Alice
+	// 2 Bob
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package seq 提供基于 Go 1.23 迭代器（iter.Seq / iter.Seq2）的惰性切片操作
+// 和 slice 包不同，这里的方法不会创建中间切片，只有在遍历的时候才会真正执行，
+// 适合对大的结果集做多步组合处理。需要结果切片或者 map 的时候，使用 Collect、ToMap、ToMapV 收集。
+//
+// 带下标的 iter.Seq2[int, T]（例如 slices.All、Enumerate 的结果）可以使用 Map2、Filter2、FilterMap2 处理，
+// 这些方法会保留原始的下标；使用 Values 丢弃下标之后即可接回 Take、Skip、Collect 等方法。
+package seq
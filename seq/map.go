@@ -0,0 +1,60 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import "iter"
+
+// Map 惰性地将 seq 中的每一个元素转化为 Dst
+// idx 是元素在 seq 中的位置，从 0 开始
+func Map[Src any, Dst any](seq iter.Seq[Src], m func(idx int, src Src) Dst) iter.Seq[Dst] {
+	return func(yield func(Dst) bool) {
+		idx := 0
+		for s := range seq {
+			if !yield(m(idx, s)) {
+				return
+			}
+			idx++
+		}
+	}
+}
+
+// Filter 惰性地过滤元素，只保留 m 返回 true 的元素
+// idx 是元素在原始 seq 中的位置，而不是过滤之后的位置
+func Filter[T any](seq iter.Seq[T], m func(idx int, src T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		idx := 0
+		for s := range seq {
+			if m(idx, s) && !yield(s) {
+				return
+			}
+			idx++
+		}
+	}
+}
+
+// FilterMap 惰性地执行过滤并且转化
+// 如果 m 的第二个返回值是 false，那么我们会忽略第一个返回值
+// 即便第二个返回值是 false，后续的元素依旧会被遍历
+func FilterMap[Src any, Dst any](seq iter.Seq[Src], m func(idx int, src Src) (Dst, bool)) iter.Seq[Dst] {
+	return func(yield func(Dst) bool) {
+		idx := 0
+		for s := range seq {
+			if dst, ok := m(idx, s); ok && !yield(dst) {
+				return
+			}
+			idx++
+		}
+	}
+}
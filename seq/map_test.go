@@ -0,0 +1,122 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []string
+	}{
+		{
+			name: "src nil",
+			want: []string{},
+		},
+		{
+			name: "src has element",
+			src:  []int{1, 2, 3},
+			want: []string{"0:1", "1:2", "2:3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Collect(Map(slices.Values(tt.src), func(idx int, src int) string {
+				return fmt.Sprintf("%d:%d", idx, src)
+			}))
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "src nil",
+			want: []int{},
+		},
+		{
+			name: "src has element",
+			src:  []int{1, 2, 3, 4, 5},
+			want: []int{2, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Collect(Filter(slices.Values(tt.src), func(idx int, src int) bool {
+				return src%2 == 0
+			}))
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []string
+	}{
+		{
+			name: "src nil",
+			want: []string{},
+		},
+		{
+			name: "src has element",
+			src:  []int{1, -2, 3},
+			want: []string{"1", "3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Collect(FilterMap(slices.Values(tt.src), func(idx int, src int) (string, bool) {
+				return strconv.Itoa(src), src >= 0
+			}))
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestMapIsLazy(t *testing.T) {
+	cnt := 0
+	mapped := Map(slices.Values([]int{1, 2, 3, 4}), func(idx int, src int) int {
+		cnt++
+		return src * 2
+	})
+	assert.Equal(t, 0, cnt)
+	assert.Equal(t, []int{2, 4}, Collect(Take(mapped, 2)))
+	assert.Equal(t, 2, cnt)
+}
+
+func ExampleMap() {
+	src := slices.Values([]int{1, 2, 3})
+	dst := Map(src, func(idx int, src int) string {
+		return strconv.Itoa(src)
+	})
+	fmt.Println(Collect(dst))
+	// Output: [1 2 3]
+}
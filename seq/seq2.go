@@ -0,0 +1,64 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import "iter"
+
+// Map2 惰性地将 seq 中的每一个元素转化为 Dst，下标保持不变
+// 和 Map 不同，idx 取自 seq 本身，例如 slices.All 或者 Enumerate 给出的下标
+func Map2[Src any, Dst any](seq iter.Seq2[int, Src], m func(idx int, src Src) Dst) iter.Seq2[int, Dst] {
+	return func(yield func(int, Dst) bool) {
+		for idx, s := range seq {
+			if !yield(idx, m(idx, s)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter2 惰性地过滤元素，只保留 m 返回 true 的元素，下标保持不变
+func Filter2[T any](seq iter.Seq2[int, T], m func(idx int, src T) bool) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for idx, s := range seq {
+			if m(idx, s) && !yield(idx, s) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMap2 惰性地执行过滤并且转化，下标保持不变
+// 如果 m 的第二个返回值是 false，那么我们会忽略第一个返回值
+func FilterMap2[Src any, Dst any](seq iter.Seq2[int, Src], m func(idx int, src Src) (Dst, bool)) iter.Seq2[int, Dst] {
+	return func(yield func(int, Dst) bool) {
+		for idx, s := range seq {
+			if dst, ok := m(idx, s); ok && !yield(idx, dst) {
+				return
+			}
+		}
+	}
+}
+
+// Values 丢弃下标，只保留 seq 中的元素
+// 可以用来把 Seq2 接回到 Take、Skip、Collect 等只接收 Seq 的方法上
+func Values[T any](seq iter.Seq2[int, T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, s := range seq {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
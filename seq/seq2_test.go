@@ -0,0 +1,149 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap2(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want map[int]string
+	}{
+		{
+			name: "src nil",
+			want: map[int]string{},
+		},
+		{
+			name: "src has element",
+			src:  []int{1, 2, 3},
+			want: map[int]string{0: "0:1", 1: "1:2", 2: "2:3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := maps.Collect(Map2(slices.All(tt.src), func(idx int, src int) string {
+				return fmt.Sprintf("%d:%d", idx, src)
+			}))
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestFilter2(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want map[int]int
+	}{
+		{
+			name: "src nil",
+			want: map[int]int{},
+		},
+		{
+			name: "keep original index",
+			src:  []int{1, 2, 3, 4, 5},
+			want: map[int]int{1: 2, 3: 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := maps.Collect(Filter2(slices.All(tt.src), func(idx int, src int) bool {
+				return src%2 == 0
+			}))
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestFilterMap2(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want map[int]string
+	}{
+		{
+			name: "src nil",
+			want: map[int]string{},
+		},
+		{
+			name: "keep original index",
+			src:  []int{1, -2, 3},
+			want: map[int]string{0: "1", 2: "3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := maps.Collect(FilterMap2(slices.All(tt.src), func(idx int, src int) (string, bool) {
+				return strconv.Itoa(src), src >= 0
+			}))
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "src nil",
+			want: []int{},
+		},
+		{
+			name: "src has element",
+			src:  []int{1, 2, 3},
+			want: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Collect(Values(slices.All(tt.src))))
+		})
+	}
+}
+
+func TestMap2IsLazy(t *testing.T) {
+	cnt := 0
+	mapped := Map2(slices.All([]int{1, 2, 3, 4}), func(idx int, src int) int {
+		cnt++
+		return src * 2
+	})
+	assert.Equal(t, 0, cnt)
+	assert.Equal(t, []int{2, 4}, Collect(Take(Values(mapped), 2)))
+	assert.Equal(t, 2, cnt)
+}
+
+func ExampleFilter2() {
+	src := slices.All([]string{"a", "", "b"})
+	for idx, s := range Filter2(src, func(idx int, src string) bool {
+		return src != ""
+	}) {
+		fmt.Println(idx, s)
+	}
+	// Output:
+	// 0 a
+	// 2 b
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import "iter"
+
+// Take 只返回 seq 的前 n 个元素
+// 取满 n 个元素之后就会停止遍历 seq，因此可以用于无限序列
+// n <= 0 的时候返回一个空序列
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		cnt := 0
+		for s := range seq {
+			if !yield(s) {
+				return
+			}
+			cnt++
+			if cnt >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip 跳过 seq 的前 n 个元素，返回剩余的元素
+// n <= 0 的时候返回全部元素
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		cnt := 0
+		for s := range seq {
+			if cnt < n {
+				cnt++
+				continue
+			}
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile 返回 seq 开头连续满足 m 的元素
+// 一旦遇到第一个不满足 m 的元素就停止遍历
+func TakeWhile[T any](seq iter.Seq[T], m func(idx int, src T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		idx := 0
+		for s := range seq {
+			if !m(idx, s) || !yield(s) {
+				return
+			}
+			idx++
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seq
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// naturals 返回一个无限的自然数序列
+func naturals() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		n    int
+		want []int
+	}{
+		{
+			name: "src nil",
+			n:    2,
+			want: []int{},
+		},
+		{
+			name: "n is negative",
+			src:  []int{1, 2, 3},
+			n:    -1,
+			want: []int{},
+		},
+		{
+			name: "n is zero",
+			src:  []int{1, 2, 3},
+			n:    0,
+			want: []int{},
+		},
+		{
+			name: "n less than length",
+			src:  []int{1, 2, 3},
+			n:    2,
+			want: []int{1, 2},
+		},
+		{
+			name: "n greater than length",
+			src:  []int{1, 2, 3},
+			n:    5,
+			want: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Collect(Take(slices.Values(tt.src), tt.n)))
+		})
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, Collect(Take(naturals(), 3)))
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		n    int
+		want []int
+	}{
+		{
+			name: "src nil",
+			n:    2,
+			want: []int{},
+		},
+		{
+			name: "n is negative",
+			src:  []int{1, 2, 3},
+			n:    -1,
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "n less than length",
+			src:  []int{1, 2, 3},
+			n:    2,
+			want: []int{3},
+		},
+		{
+			name: "n greater than length",
+			src:  []int{1, 2, 3},
+			n:    5,
+			want: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Collect(Skip(slices.Values(tt.src), tt.n)))
+		})
+	}
+
+	assert.Equal(t, []int{3, 4}, Collect(Take(Skip(naturals(), 3), 2)))
+}
+
+func TestTakeWhile(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "src nil",
+			want: []int{},
+		},
+		{
+			name: "stop at first mismatch",
+			src:  []int{1, 2, 5, 1},
+			want: []int{1, 2},
+		},
+		{
+			name: "all match",
+			src:  []int{1, 2, 3},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "first mismatch",
+			src:  []int{5, 1},
+			want: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Collect(TakeWhile(slices.Values(tt.src), func(idx int, src int) bool {
+				return src < 4
+			}))
+			assert.Equal(t, tt.want, res)
+		})
+	}
+
+	res := Collect(TakeWhile(naturals(), func(idx int, src int) bool {
+		return src*src < 10
+	}))
+	assert.Equal(t, []int{0, 1, 2, 3}, res)
+}
+
+func ExampleSkip() {
+	res := Take(Skip(naturals(), 10), 3)
+	fmt.Println(Collect(res))
+	// Output: [10 11 12]
+}
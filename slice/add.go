@@ -24,3 +24,10 @@ func Add[Src any](src []Src, element Src, index int) ([]Src, error) {
 	res, err := slice.Add[Src](src, element, index)
 	return res, err
 }
+
+// AddAll 在切片的指定位置插入多个元素，并返回新的切片
+// 插入之后 elements[0] 位于 index 处，elements 的顺序保持不变
+// 如果 index 超出范围（< 0 或 > len(src)），返回错误
+func AddAll[Src any](src []Src, elements []Src, index int) ([]Src, error) {
+	return slice.AddAll[Src](src, elements, index)
+}
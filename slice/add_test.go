@@ -17,6 +17,7 @@ package slice
 import (
 	"fmt"
 	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/hanleilei/arktools/testutil"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -104,7 +105,7 @@ func ExampleAdd() {
 	// Output:
 	// [1 2 233 3 4]
 	// [233]
-	// ekit: 下标超出范围，长度 4, 下标 -1
+	// arktools: 下标超出范围，长度 4, 下标 -1
 }
 
 func TestAddString(t *testing.T) {
@@ -157,3 +158,45 @@ func TestAddString(t *testing.T) {
 		})
 	}
 }
+
+func TestAddAll(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []testutil.Person
+		addVals   []testutil.Person
+		index     int
+		wantSlice []testutil.Person
+		wantErr   error
+	}{
+		{
+			name:    "insert in middle",
+			slice:   []testutil.Person{{Name: "Alice", Age: 30}, {Name: "David", Age: 40}},
+			addVals: []testutil.Person{{Name: "Bob", Age: 25}, {Name: "Eve", Age: 28}},
+			index:   1,
+			wantSlice: []testutil.Person{
+				{Name: "Alice", Age: 30},
+				{Name: "Bob", Age: 25},
+				{Name: "Eve", Age: 28},
+				{Name: "David", Age: 40},
+			},
+		},
+		{
+			name:    "index out of range",
+			slice:   []testutil.Person{{Name: "Test", Age: 20}},
+			addVals: []testutil.Person{{Name: "Bob", Age: 25}},
+			index:   5,
+			wantErr: errs.NewErrIndexOutOfRange(1, 5),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := AddAll(tc.slice, tc.addVals, tc.index)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
@@ -15,15 +15,19 @@
 package slice
 
 import (
+	"math"
+
 	"github.com/hanleilei/arktools"
+	"github.com/hanleilei/arktools/internal/errs"
 )
 
 // Max 返回最大值。
 // 该方法假设你至少会传入一个值。
 // 如果 ts 为空或为 nil，会 panic。
-// 支持 arktools.RealNumber（int/float）和 string。
+// 支持所有 arktools.Ordered 类型，包括整数、浮点数和 string。
 // 在使用 float32 或者 float64 的时候要小心精度问题。
-func Max[T arktools.RealNumber | ~string](ts []T) T {
+// 对于 NaN，结果取决于它出现的位置，如果需要确定的语义，请使用 MaxFloat。
+func Max[T arktools.Ordered](ts []T) T {
 	if len(ts) == 0 {
 		panic("Max: empty slice")
 	}
@@ -39,9 +43,10 @@ func Max[T arktools.RealNumber | ~string](ts []T) T {
 // Min 返回最小值。
 // 该方法假设你至少会传入一个值。
 // 如果 ts 为空或为 nil，会 panic。
-// 支持 arktools.RealNumber（int/float）和 string。
+// 支持所有 arktools.Ordered 类型，包括整数、浮点数和 string。
 // 在使用 float32 或者 float64 的时候要小心精度问题。
-func Min[T arktools.RealNumber | ~string](ts []T) T {
+// 对于 NaN，结果取决于它出现的位置，如果需要确定的语义，请使用 MinFloat。
+func Min[T arktools.Ordered](ts []T) T {
 	if len(ts) == 0 {
 		panic("Min: empty slice")
 	}
@@ -54,10 +59,108 @@ func Min[T arktools.RealNumber | ~string](ts []T) T {
 	return res
 }
 
+// MaxE 返回最大值。
+// 和 Max 不同的是，如果 ts 为空或为 nil，会返回 ErrEmptySlice 而不是 panic。
+// 如果存在多个最大值，返回第一个。
+func MaxE[T arktools.Ordered](ts []T) (T, error) {
+	return MaxFunc[T](ts, compareOrdered[T])
+}
+
+// MinE 返回最小值。
+// 和 Min 不同的是，如果 ts 为空或为 nil，会返回 ErrEmptySlice 而不是 panic。
+// 如果存在多个最小值，返回第一个。
+func MinE[T arktools.Ordered](ts []T) (T, error) {
+	return MinFunc[T](ts, compareOrdered[T])
+}
+
+// MaxFunc 按照 cmp 返回最大的元素，支持任意类型。
+// cmp 的返回值小于 0 表示 a < b，等于 0 表示 a == b，大于 0 表示 a > b。
+// 如果存在多个最大值，返回第一个。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func MaxFunc[T any](ts []T, cmp func(a, b T) int) (T, error) {
+	if len(ts) == 0 {
+		var zero T
+		return zero, errs.ErrEmptySlice
+	}
+	res := ts[0]
+	for i := 1; i < len(ts); i++ {
+		if cmp(ts[i], res) > 0 {
+			res = ts[i]
+		}
+	}
+	return res, nil
+}
+
+// MinFunc 按照 cmp 返回最小的元素，支持任意类型。
+// cmp 的返回值小于 0 表示 a < b，等于 0 表示 a == b，大于 0 表示 a > b。
+// 如果存在多个最小值，返回第一个。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func MinFunc[T any](ts []T, cmp func(a, b T) int) (T, error) {
+	if len(ts) == 0 {
+		var zero T
+		return zero, errs.ErrEmptySlice
+	}
+	res := ts[0]
+	for i := 1; i < len(ts); i++ {
+		if cmp(ts[i], res) < 0 {
+			res = ts[i]
+		}
+	}
+	return res, nil
+}
+
+// MaxBy 返回 key 最大的元素，key 由使用者从元素中提取。
+// 每个元素的 key 只会被计算一次。
+// 如果存在多个最大值，返回第一个。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func MaxBy[T any, K arktools.Ordered](ts []T, key func(t T) K) (T, error) {
+	if len(ts) == 0 {
+		var zero T
+		return zero, errs.ErrEmptySlice
+	}
+	res, resKey := ts[0], key(ts[0])
+	for i := 1; i < len(ts); i++ {
+		if k := key(ts[i]); k > resKey {
+			res, resKey = ts[i], k
+		}
+	}
+	return res, nil
+}
+
+// MinBy 返回 key 最小的元素，key 由使用者从元素中提取。
+// 每个元素的 key 只会被计算一次。
+// 如果存在多个最小值，返回第一个。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func MinBy[T any, K arktools.Ordered](ts []T, key func(t T) K) (T, error) {
+	if len(ts) == 0 {
+		var zero T
+		return zero, errs.ErrEmptySlice
+	}
+	res, resKey := ts[0], key(ts[0])
+	for i := 1; i < len(ts); i++ {
+		if k := key(ts[i]); k < resKey {
+			res, resKey = ts[i], k
+		}
+	}
+	return res, nil
+}
+
+// compareOrdered 按照 < 和 > 比较两个值
+func compareOrdered[T arktools.Ordered](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
 // Sum 求和。
 // 对于 nil 或空切片，返回零值。
 // 支持 arktools.Number（int/float），不支持 string。
 // 在使用 float32 或者 float64 的时候要小心精度问题。
+// 整数溢出的时候会静默回绕，如果需要感知溢出，请使用 SumChecked 或者 SumInt64 等方法。
 func Sum[T arktools.Number](ts []T) T {
 	var res T
 	for _, n := range ts {
@@ -65,3 +168,55 @@ func Sum[T arktools.Number](ts []T) T {
 	}
 	return res
 }
+
+// SumChecked 求和，并且在溢出的时候返回错误而不是静默回绕。
+// 对于整数，结果超出类型的表示范围即为溢出；
+// 对于浮点数，有限值相加得到无穷大即为溢出。
+// 对于 nil 或空切片，返回零值。
+func SumChecked[T arktools.RealNumber](ts []T) (T, error) {
+	var res T
+	for _, n := range ts {
+		sum := res + n
+		if (n > 0 && sum < res) || (n < 0 && sum > res) ||
+			(math.IsInf(float64(sum), 0) && !math.IsInf(float64(res), 0) && !math.IsInf(float64(n), 0)) {
+			var zero T
+			return zero, errs.NewErrOverflow("+", res, n)
+		}
+		res = sum
+	}
+	return res, nil
+}
+
+// SumInt64 将有符号整数累加到 int64 中求和。
+// 不管 T 是 int8 还是 int32，中间结果都不会在 T 的范围内溢出。
+// 对于 nil 或空切片，返回 0。
+func SumInt64[T arktools.Signed](ts []T) int64 {
+	var res int64
+	for _, n := range ts {
+		res += int64(n)
+	}
+	return res
+}
+
+// SumUint64 将无符号整数累加到 uint64 中求和。
+// 不管 T 是 uint8 还是 uint32，中间结果都不会在 T 的范围内溢出。
+// 对于 nil 或空切片，返回 0。
+func SumUint64[T arktools.Unsigned](ts []T) uint64 {
+	var res uint64
+	for _, n := range ts {
+		res += uint64(n)
+	}
+	return res
+}
+
+// SumFloat64 将元素累加到 float64 中求和。
+// 适用于元素类型太小、或者需要和其它浮点数继续计算的场景。
+// 在使用 float32 或者 float64 的时候要小心精度问题。
+// 对于 nil 或空切片，返回 0。
+func SumFloat64[T arktools.RealNumber](ts []T) float64 {
+	var res float64
+	for _, n := range ts {
+		res += float64(n)
+	}
+	return res
+}
@@ -17,8 +17,12 @@ package slice
 import (
 	"fmt"
 	"github.com/hanleilei/arktools"
+	"math"
+	"strings"
 	"testing"
 
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/hanleilei/arktools/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -66,6 +70,7 @@ func TestMax(t *testing.T) {
 	testMaxTypes[int64](t)
 	testMaxTypes[float32](t)
 	testMaxTypes[float64](t)
+	testMaxTypes[uintptr](t)
 }
 
 func TestMin(t *testing.T) {
@@ -112,6 +117,7 @@ func TestMin(t *testing.T) {
 	testMinTypes[int64](t)
 	testMinTypes[float32](t)
 	testMinTypes[float64](t)
+	testMinTypes[uintptr](t)
 }
 
 func TestSum(t *testing.T) {
@@ -165,6 +171,15 @@ func TestMaxString(t *testing.T) {
 	assert.Panics(t, func() { Max[string]([]string{}) })
 }
 
+func TestMaxOrdered(t *testing.T) {
+	type Name string
+	assert.Equal(t, Name("c"), Max([]Name{"a", "c", "b"}))
+	assert.Equal(t, Name("a"), Min([]Name{"a", "c", "b"}))
+	res, err := MaxE([]Name{"a", "c", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, Name("c"), res)
+}
+
 func TestMinString(t *testing.T) {
 	src := []string{"a", "c", "b"}
 	assert.Equal(t, "a", Min(src))
@@ -176,14 +191,251 @@ func TestSumString(t *testing.T) {
 	// _ = Sum[string]([]string{"a", "b", "c"}) // 编译期应报错
 }
 
+func TestMaxE(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []Integer
+		want    Integer
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "empty",
+			input:   []Integer{},
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:  "value",
+			input: []Integer{1},
+			want:  1,
+		},
+		{
+			name:  "values",
+			input: []Integer{2, 3, 1},
+			want:  3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := MaxE[Integer](tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	res, err := MaxE([]string{"a", "c", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "c", res)
+}
+
+func TestMinE(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []Integer
+		want    Integer
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "empty",
+			input:   []Integer{},
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:  "value",
+			input: []Integer{3},
+			want:  3,
+		},
+		{
+			name:  "values",
+			input: []Integer{3, 1, 2},
+			want:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := MinE[Integer](tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	res, err := MinE([]string{"a", "c", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", res)
+}
+
+func TestMaxFuncAndMinFunc(t *testing.T) {
+	byAge := func(a, b testutil.Person) int {
+		return a.Age - b.Age
+	}
+	testCases := []struct {
+		name    string
+		input   []testutil.Person
+		wantMax testutil.Person
+		wantMin testutil.Person
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "empty",
+			input:   []testutil.Person{},
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "value",
+			input:   []testutil.Person{{Name: "Alice", Age: 30}},
+			wantMax: testutil.Person{Name: "Alice", Age: 30},
+			wantMin: testutil.Person{Name: "Alice", Age: 30},
+		},
+		{
+			name: "values",
+			input: []testutil.Person{
+				{Name: "Alice", Age: 30},
+				{Name: "Bob", Age: 25},
+				{Name: "David", Age: 40},
+			},
+			wantMax: testutil.Person{Name: "David", Age: 40},
+			wantMin: testutil.Person{Name: "Bob", Age: 25},
+		},
+		{
+			name: "ties return the first one",
+			input: []testutil.Person{
+				{Name: "Alice", Age: 30},
+				{Name: "Bob", Age: 30},
+			},
+			wantMax: testutil.Person{Name: "Alice", Age: 30},
+			wantMin: testutil.Person{Name: "Alice", Age: 30},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			maxRes, err := MaxFunc(tc.input, byAge)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMax, maxRes)
+			minRes, err := MinFunc(tc.input, byAge)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMin, minRes)
+		})
+	}
+}
+
+func TestMaxByAndMinBy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []testutil.Person
+		key     func(p testutil.Person) string
+		wantMax testutil.Person
+		wantMin testutil.Person
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			key:     func(p testutil.Person) string { return p.Name },
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name: "by name",
+			input: []testutil.Person{
+				{Name: "Bob", Age: 25},
+				{Name: "Alice", Age: 30},
+				{Name: "David", Age: 40},
+			},
+			key:     func(p testutil.Person) string { return p.Name },
+			wantMax: testutil.Person{Name: "David", Age: 40},
+			wantMin: testutil.Person{Name: "Alice", Age: 30},
+		},
+		{
+			name: "ties return the first one",
+			input: []testutil.Person{
+				{Name: "bob", Age: 25},
+				{Name: "BOB", Age: 30},
+			},
+			key:     func(p testutil.Person) string { return strings.ToLower(p.Name) },
+			wantMax: testutil.Person{Name: "bob", Age: 25},
+			wantMin: testutil.Person{Name: "bob", Age: 25},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			maxRes, err := MaxBy(tc.input, tc.key)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMax, maxRes)
+			minRes, err := MinBy(tc.input, tc.key)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantMin, minRes)
+		})
+	}
+
+	oldest, err := MaxBy([]testutil.Person{{Name: "Alice", Age: 30}, {Name: "David", Age: 40}},
+		func(p testutil.Person) int { return p.Age })
+	assert.NoError(t, err)
+	assert.Equal(t, "David", oldest.Name)
+}
+
+func TestSumChecked(t *testing.T) {
+	res, err := SumChecked[int8](nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(0), res)
+
+	res, err = SumChecked([]int8{100, 27, -10})
+	assert.NoError(t, err)
+	assert.Equal(t, int8(117), res)
+
+	_, err = SumChecked([]int8{100, 27, 1})
+	assert.Equal(t, errs.NewErrOverflow("+", int8(127), int8(1)), err)
+
+	_, err = SumChecked([]int8{-100, -28, -1})
+	assert.Equal(t, errs.NewErrOverflow("+", int8(-128), int8(-1)), err)
+
+	_, err = SumChecked([]uint32{math.MaxUint32, 1})
+	assert.Equal(t, errs.NewErrOverflow("+", uint32(math.MaxUint32), uint32(1)), err)
+
+	fres, err := SumChecked([]float32{1.5, 2.5})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(4), fres)
+
+	_, err = SumChecked([]float32{math.MaxFloat32, math.MaxFloat32})
+	assert.Error(t, err)
+
+	// 本身就是无穷大的不算溢出
+	dres, err := SumChecked([]float64{math.Inf(1), 1})
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(dres, 1))
+}
+
+func TestWideningSum(t *testing.T) {
+	assert.Equal(t, int64(0), SumInt64[int8](nil))
+	assert.Equal(t, int64(381), SumInt64([]int8{127, 127, 127}))
+	assert.Equal(t, int64(-384), SumInt64([]int8{-128, -128, -128}))
+	assert.Equal(t, uint64(0), SumUint64[uint8](nil))
+	assert.Equal(t, uint64(2*math.MaxUint32), SumUint64([]uint32{math.MaxUint32, math.MaxUint32}))
+	assert.Equal(t, float64(0), SumFloat64[int](nil))
+	assert.Equal(t, float64(765), SumFloat64([]uint8{255, 255, 255}))
+	assert.Equal(t, 1.5, SumFloat64([]float32{1, 0.5}))
+}
+
 // testMaxTypes 只是用来测试一下满足 Max 方法约束的所有类型
-func testMaxTypes[T arktools.RealNumber](t *testing.T) {
+func testMaxTypes[T arktools.Integer | arktools.Float](t *testing.T) {
 	res := Max[T]([]T{1, 2, 3})
 	assert.Equal(t, T(3), res)
 }
 
 // testMinTypes 只是用来测试一下满足 Min 方法约束的所有类型
-func testMinTypes[T arktools.RealNumber](t *testing.T) {
+func testMinTypes[T arktools.Integer | arktools.Float](t *testing.T) {
 	res := Min[T]([]T{1, 2, 3})
 	assert.Equal(t, T(1), res)
 }
@@ -219,3 +471,32 @@ func ExampleMax() {
 	// Output:
 	// 3
 }
+
+func ExampleMaxE() {
+	_, err := MaxE[int](nil)
+	fmt.Println(err == ErrEmptySlice)
+	res, err := MaxE[int]([]int{1, 2, 3})
+	fmt.Println(res, err)
+	// Output:
+	// true
+	// 3 <nil>
+}
+
+func ExampleMaxBy() {
+	people := []testutil.Person{{Name: "Alice", Age: 30}, {Name: "David", Age: 40}}
+	res, _ := MaxBy(people, func(p testutil.Person) int {
+		return p.Age
+	})
+	fmt.Println(res.Name)
+	// Output:
+	// David
+}
+
+func ExampleSumChecked() {
+	_, err := SumChecked([]uint8{200, 100})
+	fmt.Println(err != nil)
+	fmt.Println(SumUint64([]uint8{200, 100}))
+	// Output:
+	// true
+	// 300
+}
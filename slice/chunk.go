@@ -0,0 +1,91 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import "github.com/hanleilei/arktools/internal/errs"
+
+// Chunk 将 src 按照 size 个元素一组进行切分，最后一组的元素个数可能少于 size
+// 返回的每一组都是 src 的子切片，不会复制元素，因此修改组内的元素会影响 src
+// 但是每一组的容量都被限制在自身长度，对其 append 会触发扩容，不会覆盖下一组的元素
+// 如果 size 小于等于 0，返回 errs.NewErrInvalidSize
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Chunk[T any](src []T, size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, errs.NewErrInvalidSize(size)
+	}
+	// 不使用 (len(src)+size-1)/size，避免 size 很大的时候溢出
+	res := make([][]T, 0, len(src)/size+min(len(src)%size, 1))
+	for i := 0; i < len(src); i += size {
+		end := i + min(size, len(src)-i)
+		res = append(res, src[i:end:end])
+	}
+	return res, nil
+}
+
+// Window 返回 src 上所有长度为 size 的滑动窗口，相邻两个窗口的起始位置相差 step
+// 只返回完整的窗口，如果 src 的长度小于 size，返回空切片
+// 和 Chunk 一样，窗口是 src 的子切片并且容量被限制在自身长度
+// 当 step 小于 size 时窗口之间共享元素，修改其中一个窗口会影响其它窗口
+// 如果 size 小于等于 0，返回 errs.NewErrInvalidSize
+// 如果 step 小于等于 0，返回 errs.NewErrInvalidArgument
+func Window[T any](src []T, size int, step int) ([][]T, error) {
+	if size <= 0 {
+		return nil, errs.NewErrInvalidSize(size)
+	}
+	if step <= 0 {
+		return nil, errs.NewErrInvalidArgument("step", step)
+	}
+	if len(src) < size {
+		return [][]T{}, nil
+	}
+	// 最后一个窗口的起始位置是 last，len(src)-size 不会溢出
+	last := len(src) - size
+	res := make([][]T, 0, last/step+1)
+	for i := 0; i <= last; i += step {
+		res = append(res, src[i:i+size:i+size])
+		// 先判断再累加，避免 i += step 溢出
+		if step > last-i {
+			break
+		}
+	}
+	return res, nil
+}
+
+// BatchFunc 按照元素的权重切分 src，保证每一组的权重之和不超过 limit
+// weight 用于计算元素的权重，例如序列化之后的字节数
+// 元素按照原本的顺序依次放入当前组，当放入下一个元素会超过 limit 时开启新的一组
+// 单个元素的权重已经超过 limit 时，它会单独成为一组，而不是被丢弃
+// 返回的每一组都是 src 的子切片，容量被限制在自身长度
+// 如果 limit 小于等于 0，返回 errs.NewErrInvalidSize
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func BatchFunc[T any](src []T, limit int, weight func(idx int, src T) int) ([][]T, error) {
+	if limit <= 0 {
+		return nil, errs.NewErrInvalidSize(limit)
+	}
+	res := make([][]T, 0, 1)
+	start, total := 0, 0
+	for i, s := range src {
+		w := weight(i, s)
+		if i > start && total+w > limit {
+			res = append(res, src[start:i:i])
+			start, total = i, 0
+		}
+		total += w
+	}
+	if start < len(src) {
+		res = append(res, src[start:len(src):len(src)])
+	}
+	return res, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		size    int
+		want    [][]int
+		wantErr error
+	}{
+		{
+			name:    "invalid size",
+			src:     []int{1, 2, 3},
+			size:    0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:    "negative size",
+			src:     []int{1, 2, 3},
+			size:    -1,
+			wantErr: errs.NewErrInvalidSize(-1),
+		},
+		{
+			name: "nil",
+			size: 2,
+			want: [][]int{},
+		},
+		{
+			name: "exact",
+			src:  []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "remainder",
+			src:  []int{1, 2, 3, 4, 5},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "size larger than length",
+			src:  []int{1, 2, 3},
+			size: 5,
+			want: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "max size",
+			src:  []int{1, 2},
+			size: math.MaxInt,
+			want: [][]int{{1, 2}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Chunk(tc.src, tc.size)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestChunk_ZeroCopy(t *testing.T) {
+	src := []int{1, 2, 3, 4}
+	res, err := Chunk(src, 2)
+	assert.NoError(t, err)
+	// 共享底层数组
+	res[0][0] = 10
+	assert.Equal(t, 10, src[0])
+	// append 不会覆盖下一组
+	_ = append(res[0], 100)
+	assert.Equal(t, []int{3, 4}, res[1])
+	assert.Equal(t, []int{10, 2, 3, 4}, src)
+}
+
+func TestWindow(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		size    int
+		step    int
+		want    [][]int
+		wantErr error
+	}{
+		{
+			name:    "invalid size",
+			src:     []int{1, 2, 3},
+			size:    0,
+			step:    1,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:    "invalid step",
+			src:     []int{1, 2, 3},
+			size:    2,
+			step:    0,
+			wantErr: errs.NewErrInvalidArgument("step", 0),
+		},
+		{
+			name: "nil",
+			size: 2,
+			step: 1,
+			want: [][]int{},
+		},
+		{
+			name: "shorter than size",
+			src:  []int{1, 2},
+			size: 3,
+			step: 1,
+			want: [][]int{},
+		},
+		{
+			name: "sliding",
+			src:  []int{1, 2, 3, 4, 5},
+			size: 3,
+			step: 1,
+			want: [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}},
+		},
+		{
+			name: "step equals size",
+			src:  []int{1, 2, 3, 4, 5},
+			size: 2,
+			step: 2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "step larger than size",
+			src:  []int{1, 2, 3, 4, 5, 6, 7},
+			size: 2,
+			step: 3,
+			want: [][]int{{1, 2}, {4, 5}},
+		},
+		{
+			name: "max step",
+			src:  []int{1, 2, 3},
+			size: 1,
+			step: math.MaxInt,
+			want: [][]int{{1}},
+		},
+		{
+			name: "max size",
+			src:  []int{1, 2, 3},
+			size: math.MaxInt,
+			step: 1,
+			want: [][]int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Window(tc.src, tc.size, tc.step)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestBatchFunc(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []string
+		limit   int
+		want    [][]string
+		wantErr error
+	}{
+		{
+			name:    "invalid limit",
+			src:     []string{"a"},
+			limit:   0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:  "nil",
+			limit: 3,
+			want:  [][]string{},
+		},
+		{
+			name:  "all in one batch",
+			src:   []string{"a", "bb"},
+			limit: 3,
+			want:  [][]string{{"a", "bb"}},
+		},
+		{
+			name:  "split",
+			src:   []string{"a", "bb", "c", "dd", "e"},
+			limit: 3,
+			want:  [][]string{{"a", "bb"}, {"c", "dd"}, {"e"}},
+		},
+		{
+			name:  "element heavier than limit",
+			src:   []string{"a", "bbbb", "c"},
+			limit: 3,
+			want:  [][]string{{"a"}, {"bbbb"}, {"c"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := BatchFunc(tc.src, tc.limit, func(idx int, src string) int {
+				return len(src)
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func ExampleChunk() {
+	res, _ := Chunk([]int{1, 2, 3, 4, 5}, 2)
+	fmt.Println(res)
+	// Output:
+	// [[1 2] [3 4] [5]]
+}
+
+func ExampleWindow() {
+	res, _ := Window([]int{1, 2, 3, 4, 5}, 3, 1)
+	fmt.Println(res)
+	// Output:
+	// [[1 2 3] [2 3 4] [3 4 5]]
+}
+
+func ExampleBatchFunc() {
+	res, _ := BatchFunc([]string{"a", "bb", "c", "dd", "e"}, 3, func(idx int, src string) int {
+		return len(src)
+	})
+	fmt.Println(res)
+	// Output:
+	// [[a bb] [c dd] [e]]
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+// Deduplicate 去重，只支持 comparable
+// 返回值保留每个元素第一次出现的顺序，时间复杂度 O(n)
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Deduplicate[T comparable](data []T) []T {
+	return DeduplicateBy[T, T](data, func(src T) T {
+		return src
+	})
+}
+
+// DeduplicateFunc 去重，支持任意类型
+// 返回值保留每个元素第一次出现的顺序
+// 因为只能两两比较，所以时间复杂度是 O(n^2)，
+// 如果能够从元素中提取出 comparable 的 key，应该优先使用 DeduplicateBy
+func DeduplicateFunc[T any](data []T, equal equalFunc[T]) []T {
+	var newData = make([]T, 0, len(data))
+	for _, v := range data {
+		if !ContainsFunc[T](newData, func(src T) bool {
+			return equal(src, v)
+		}) {
+			newData = append(newData, v)
+		}
+	}
+	return newData
+}
+
+// DeduplicateBy 按照 key 去重，key 相同的元素被认为是重复元素
+// 返回值保留每个 key 第一次出现的元素以及顺序，时间复杂度 O(n)
+func DeduplicateBy[T any, K comparable](data []T, key func(src T) K) []T {
+	var seen = make(map[K]struct{}, len(data))
+	var newData = make([]T, 0, len(data))
+	for _, v := range data {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		newData = append(newData, v)
+	}
+	return newData
+}
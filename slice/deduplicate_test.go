@@ -0,0 +1,117 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeduplicate(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "empty",
+			src:  []int{},
+			want: []int{},
+		},
+		{
+			name: "no duplicate",
+			src:  []int{3, 1, 2},
+			want: []int{3, 1, 2},
+		},
+		{
+			name: "keep first occurrence order",
+			src:  []int{3, 1, 3, 2, 1, 3},
+			want: []int{3, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Deduplicate(tt.src))
+			assert.Equal(t, tt.want, DeduplicateFunc(tt.src, func(src, dst int) bool {
+				return src == dst
+			}))
+		})
+	}
+}
+
+func TestDeduplicateBy(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []testutil.Person
+		want []testutil.Person
+	}{
+		{
+			name: "nil",
+			want: []testutil.Person{},
+		},
+		{
+			name: "keep the first element of each key",
+			src: []testutil.Person{
+				{Name: "Alice", Age: 30},
+				{Name: "Bob", Age: 25},
+				{Name: "alice", Age: 31},
+				{Name: "BOB", Age: 26},
+				{Name: "David", Age: 40},
+			},
+			want: []testutil.Person{
+				{Name: "Alice", Age: 30},
+				{Name: "Bob", Age: 25},
+				{Name: "David", Age: 40},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := DeduplicateBy(tt.src, func(p testutil.Person) string {
+				return strings.ToLower(p.Name)
+			})
+			assert.Equal(t, tt.want, res)
+			res = DeduplicateFunc(tt.src, func(src, dst testutil.Person) bool {
+				return strings.EqualFold(src.Name, dst.Name)
+			})
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func ExampleDeduplicate() {
+	res := Deduplicate([]int{3, 1, 3, 2, 1})
+	fmt.Println(res)
+	// Output:
+	// [3 1 2]
+}
+
+func ExampleDeduplicateBy() {
+	people := []testutil.Person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 30}, {Name: "David", Age: 40}}
+	res := DeduplicateBy(people, func(p testutil.Person) int {
+		return p.Age
+	})
+	fmt.Println(res)
+	// Output:
+	// [{Alice 30} {David 40}]
+}
@@ -24,6 +24,31 @@ func Delete[Src any](src []Src, index int) ([]Src, error) {
 	return res, err
 }
 
+// DeleteAndShrink 删除 index 处的元素，并按照 policy 对切片进行缩容
+// 如果 policy 为 nil，使用默认的阈值
+// 发生缩容的时候，返回的是一个新的切片
+func DeleteAndShrink[Src any](src []Src, index int, policy ShrinkPolicy) ([]Src, error) {
+	res, err := Delete[Src](src, index)
+	if err != nil {
+		return nil, err
+	}
+	return ShrinkFunc[Src](res, policy), nil
+}
+
+// DeleteRange 删除 [from, to) 区间内的元素
+// 考虑到性能问题，所有操作都会在原切片上进行
+// 如果 from 或者 to 超出范围，返回 IndexOutOfRange 错误；如果 from > to，返回 InvalidArgument 错误
+func DeleteRange[Src any](src []Src, from, to int) ([]Src, error) {
+	return slice.DeleteRange[Src](src, from, to)
+}
+
+// DeleteAll 删除 indexes 中所有下标对应的元素，重复的下标只会删除一次
+// 考虑到性能问题，所有操作都会在原切片上进行，并且只会遍历一次原切片
+// 只要有任何一个下标超出范围，就返回错误，并且原切片不会被修改
+func DeleteAll[Src any](src []Src, indexes ...int) ([]Src, error) {
+	return slice.DeleteAll[Src](src, indexes)
+}
+
 // FilterDelete 删除符合条件的元素
 // 考虑到性能问题，所有操作都会在原切片上进行
 // 被删除元素之后的元素会往前移动，有且只会移动一次
@@ -41,3 +66,10 @@ func FilterDelete[Src any](src []Src, m func(idx int, src Src) bool) []Src {
 	}
 	return src[:emptyPos]
 }
+
+// FilterDeleteAndShrink 删除符合条件的元素，并按照 policy 对切片进行缩容
+// 如果 policy 为 nil，使用默认的阈值
+// 发生缩容的时候，返回的是一个新的切片
+func FilterDeleteAndShrink[Src any](src []Src, m func(idx int, src Src) bool, policy ShrinkPolicy) []Src {
+	return ShrinkFunc[Src](FilterDelete[Src](src, m), policy)
+}
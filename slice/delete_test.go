@@ -65,7 +65,7 @@ func ExampleDelete() {
 	fmt.Println(err)
 	// Output:
 	// [1 2 4]
-	// ekit: 下标超出范围，长度 4, 下标 -1
+	// arktools: 下标超出范围，长度 4, 下标 -1
 }
 
 func TestFilterDelete(t *testing.T) {
@@ -193,3 +193,131 @@ func TestFilterDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteRange(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []string
+		from      int
+		to        int
+		wantSlice []string
+		wantErr   error
+	}{
+		{
+			name:      "delete middle",
+			slice:     []string{"a", "b", "c", "d"},
+			from:      1,
+			to:        3,
+			wantSlice: []string{"a", "d"},
+		},
+		{
+			name:    "to out of range",
+			slice:   []string{"a", "b"},
+			from:    0,
+			to:      5,
+			wantErr: errs.NewErrIndexOutOfRange(2, 5),
+		},
+		{
+			name:    "to less than from",
+			slice:   []string{"a", "b", "c"},
+			from:    2,
+			to:      1,
+			wantErr: errs.NewErrInvalidArgument("to", 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := DeleteRange(tc.slice, tc.from, tc.to)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []string
+		indexes   []int
+		wantSlice []string
+		wantErr   error
+	}{
+		{
+			name:      "delete some",
+			slice:     []string{"a", "b", "c", "d"},
+			indexes:   []int{3, 1},
+			wantSlice: []string{"a", "c"},
+		},
+		{
+			name:    "index out of range",
+			slice:   []string{"a", "b"},
+			indexes: []int{0, 2},
+			wantErr: errs.NewErrIndexOutOfRange(2, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := DeleteAll(tc.slice, tc.indexes...)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, res)
+		})
+	}
+}
+
+func ExampleDeleteAll() {
+	src := []int{1, 2, 3, 4, 5}
+	res, _ := DeleteAll(src, IndexAllFunc(src, func(src int) bool {
+		return src%2 == 0
+	})...)
+	fmt.Println(res)
+	// Output:
+	// [1 3 5]
+}
+
+func TestDeleteAndShrink(t *testing.T) {
+	src := make([]int, 100, 4096)
+	res, err := DeleteAndShrink(src, 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 99, len(res))
+	assert.Equal(t, 2560, cap(res))
+
+	_, err = DeleteAndShrink(src, 100, nil)
+	assert.Equal(t, errs.NewErrIndexOutOfRange(100, 100), err)
+
+	src = []int{1, 2, 3}
+	res, err = DeleteAndShrink(src, 1, func(c, l int) (int, bool) {
+		return l, c > l
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, res)
+	assert.Equal(t, 2, cap(res))
+}
+
+func TestFilterDeleteAndShrink(t *testing.T) {
+	src := make([]int, 1024)
+	for i := range src {
+		src[i] = i
+	}
+	res := FilterDeleteAndShrink(src, func(idx int, src int) bool {
+		return src >= 10
+	}, nil)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, res)
+	assert.Equal(t, 512, cap(res))
+}
+
+func TestFilterDeleteAndShrink_DeleteAll(t *testing.T) {
+	src := make([]int, 4096)
+	res := FilterDeleteAndShrink(src, func(idx int, src int) bool {
+		return true
+	}, nil)
+	assert.Equal(t, []int{}, res)
+	assert.Equal(t, 2560, cap(res))
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"github.com/hanleilei/arktools/mathx"
+	"github.com/hanleilei/arktools/tuple"
+)
+
+// Flatten 将二维切片按照顺序展开为一维切片
+// 结果的容量会预先计算好，不会在追加的过程中多次扩容
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Flatten[T any](src [][]T) []T {
+	length := 0
+	for _, s := range src {
+		length += len(s)
+	}
+	res := make([]T, 0, length)
+	for _, s := range src {
+		res = append(res, s...)
+	}
+	return res
+}
+
+// FlatMap 将每一个元素转化为一个切片，然后按照顺序展开为一维切片
+// m 的签名和 Map 保持一致，idx 是元素在 src 中的下标
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func FlatMap[Src any, Dst any](src []Src, m func(idx int, src Src) []Dst) []Dst {
+	return Flatten(Map(src, m))
+}
+
+// Product 返回 a 和 b 的笛卡尔积，结果的长度是 len(a) * len(b)
+// 结果按照 a 的顺序排列，a 中同一个元素和 b 中所有元素的组合是相邻的
+// 任何一个切片为空，结果都是空切片
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+// 如果结果的长度超出了 int 的表示范围，返回溢出错误
+func Product[A any, B any](a []A, b []B) ([]tuple.Pair[A, B], error) {
+	length, err := mathx.MulChecked(len(a), len(b))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]tuple.Pair[A, B], 0, length)
+	for _, va := range a {
+		for _, vb := range b {
+			res = append(res, tuple.NewPair(va, vb))
+		}
+	}
+	return res, nil
+}
+
+// CartesianProduct 返回多个切片的笛卡尔积，每一个组合中第 i 个元素来自 srcs[i]
+// 组合按照字典序排列，也就是最后一个切片变化得最快
+// 所有组合共享同一个预先分配的底层数组，但是容量被限制在自身长度，对其 append 不会相互覆盖
+// 没有传入切片或者任何一个切片为空，结果都是空切片
+// 如果组合的个数或者所有组合的元素总数超出了 int 的表示范围，返回溢出错误
+func CartesianProduct[T any](srcs ...[]T) ([][]T, error) {
+	if len(srcs) == 0 {
+		return [][]T{}, nil
+	}
+	// 先检查空切片，这样即使其余切片的长度相乘会溢出，结果也是空切片
+	for _, s := range srcs {
+		if len(s) == 0 {
+			return [][]T{}, nil
+		}
+	}
+	count := 1
+	for _, s := range srcs {
+		var err error
+		if count, err = mathx.MulChecked(count, len(s)); err != nil {
+			return nil, err
+		}
+	}
+	width := len(srcs)
+	total, err := mathx.MulChecked(count, width)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]T, total)
+	res := make([][]T, count)
+	// indexes 是当前组合在每一个切片中的下标，像计数器一样从最后一位开始进位
+	indexes := make([]int, width)
+	for i := range res {
+		combination := buf[i*width : (i+1)*width : (i+1)*width]
+		for j, idx := range indexes {
+			combination[j] = srcs[j][idx]
+		}
+		res[i] = combination
+		for j := width - 1; j >= 0; j-- {
+			indexes[j]++
+			if indexes[j] < len(srcs[j]) {
+				break
+			}
+			indexes[j] = 0
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/hanleilei/arktools/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  [][]int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "empty inner",
+			src:  [][]int{nil, {}},
+			want: []int{},
+		},
+		{
+			name: "values",
+			src:  [][]int{{1, 2}, nil, {3}, {4, 5}},
+			want: []int{1, 2, 3, 4, 5},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Flatten(tc.src)
+			assert.Equal(t, tc.want, res)
+			assert.Equal(t, len(tc.want), cap(res))
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []string
+		want []string
+	}{
+		{
+			name: "nil",
+			want: []string{},
+		},
+		{
+			name: "values",
+			src:  []string{"a,b", "", "c"},
+			want: []string{"0:a", "0:b", "2:c"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := FlatMap(tc.src, func(idx int, src string) []string {
+				if src == "" {
+					return nil
+				}
+				return Map(strings.Split(src, ","), func(_ int, s string) string {
+					return fmt.Sprintf("%d:%s", idx, s)
+				})
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestProduct(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    []int
+		b    []string
+		want []tuple.Pair[int, string]
+	}{
+		{
+			name: "nil",
+			want: []tuple.Pair[int, string]{},
+		},
+		{
+			name: "one empty",
+			a:    []int{1, 2},
+			want: []tuple.Pair[int, string]{},
+		},
+		{
+			name: "values",
+			a:    []int{1, 2},
+			b:    []string{"a", "b"},
+			want: []tuple.Pair[int, string]{
+				tuple.NewPair(1, "a"), tuple.NewPair(1, "b"),
+				tuple.NewPair(2, "a"), tuple.NewPair(2, "b"),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Product(tc.a, tc.b)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	// 结果的长度溢出，空结构体的切片不占用内存
+	huge := make([]struct{}, math.MaxInt/2+1)
+	_, err := Product(huge, []struct{}{{}, {}})
+	assert.Equal(t, errs.NewErrOverflow("*", math.MaxInt/2+1, 2), err)
+}
+
+func TestCartesianProduct(t *testing.T) {
+	testCases := []struct {
+		name string
+		srcs [][]int
+		want [][]int
+	}{
+		{
+			name: "no slices",
+			want: [][]int{},
+		},
+		{
+			name: "one empty",
+			srcs: [][]int{{1, 2}, {}},
+			want: [][]int{},
+		},
+		{
+			name: "single",
+			srcs: [][]int{{1, 2}},
+			want: [][]int{{1}, {2}},
+		},
+		{
+			name: "three",
+			srcs: [][]int{{1, 2}, {3}, {4, 5}},
+			want: [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := CartesianProduct(tc.srcs...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	// 组合之间互不影响
+	res, err := CartesianProduct([]int{1, 2}, []int{3})
+	assert.NoError(t, err)
+	_ = append(res[0], 100)
+	assert.Equal(t, []int{2, 3}, res[1])
+}
+
+func TestCartesianProduct_Overflow(t *testing.T) {
+	huge := make([]struct{}, 1<<32)
+	testCases := []struct {
+		name    string
+		srcs    [][]struct{}
+		wantErr error
+	}{
+		{
+			name:    "count overflow",
+			srcs:    [][]struct{}{huge, huge},
+			wantErr: errs.NewErrOverflow("*", 1<<32, 1<<32),
+		},
+		{
+			name:    "total overflow",
+			srcs:    [][]struct{}{make([]struct{}, math.MaxInt/2+1), {{}}},
+			wantErr: errs.NewErrOverflow("*", math.MaxInt/2+1, 2),
+		},
+		{
+			name:    "empty wins over overflow",
+			srcs:    [][]struct{}{huge, huge, {}},
+			wantErr: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := CartesianProduct(tc.srcs...)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func ExampleFlatMap() {
+	roles := []string{"admin", "guest"}
+	permissions := map[string][]string{
+		"admin": {"read", "write"},
+		"guest": {"read"},
+	}
+	res := FlatMap(roles, func(idx int, src string) []string {
+		return permissions[src]
+	})
+	fmt.Println(res)
+	// Output: [read write read]
+}
+
+func ExampleCartesianProduct() {
+	res, _ := CartesianProduct([]string{"linux", "darwin"}, []string{"amd64", "arm64"})
+	fmt.Println(res)
+	// Output: [[linux amd64] [linux arm64] [darwin amd64] [darwin arm64]]
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"math"
+
+	"github.com/hanleilei/arktools"
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+// NaNPolicy 决定浮点数聚合在遇到 NaN 时如何处理
+type NaNPolicy int
+
+const (
+	// NaNPropagate 只要存在 NaN，结果就是 NaN
+	NaNPropagate NaNPolicy = iota
+	// NaNSkip 忽略所有的 NaN，只使用其余的元素计算
+	NaNSkip
+	// NaNError 遇到 NaN 时返回错误，错误中包含 NaN 的下标
+	NaNError
+)
+
+// MaxFloat 返回浮点数的最大值，NaN 按照 policy 处理。
+// 无穷大按照正常的数值参与比较。
+// 如果 ts 为空或为 nil，或者在 NaNSkip 下所有元素都是 NaN，返回 ErrEmptySlice。
+func MaxFloat[T arktools.Float](ts []T, policy NaNPolicy) (T, error) {
+	return extremeFloat[T](ts, policy, func(a, b T) bool {
+		return a > b
+	})
+}
+
+// MinFloat 返回浮点数的最小值，NaN 按照 policy 处理。
+// 无穷大按照正常的数值参与比较。
+// 如果 ts 为空或为 nil，或者在 NaNSkip 下所有元素都是 NaN，返回 ErrEmptySlice。
+func MinFloat[T arktools.Float](ts []T, policy NaNPolicy) (T, error) {
+	return extremeFloat[T](ts, policy, func(a, b T) bool {
+		return a < b
+	})
+}
+
+// extremeFloat 按照 better 找出最大值或者最小值
+func extremeFloat[T arktools.Float](ts []T, policy NaNPolicy, better func(a, b T) bool) (T, error) {
+	var res T
+	found := false
+	for i, t := range ts {
+		if isNaN(t) {
+			switch policy {
+			case NaNSkip:
+				continue
+			case NaNError:
+				return 0, errs.NewErrNaN(i)
+			default:
+				return t, nil
+			}
+		}
+		if !found || better(t, res) {
+			res, found = t, true
+		}
+	}
+	if !found {
+		return 0, errs.ErrEmptySlice
+	}
+	return res, nil
+}
+
+// SumFloat 使用 Kahan 补偿求和，NaN 按照 policy 处理。
+// 对于 nil 或空切片，返回 0。
+func SumFloat[T arktools.Float](ts []T, policy NaNPolicy) (T, error) {
+	var k kahan[T]
+	for i, t := range ts {
+		if isNaN(t) {
+			switch policy {
+			case NaNSkip:
+				continue
+			case NaNError:
+				return 0, errs.NewErrNaN(i)
+			}
+		}
+		k.add(t)
+	}
+	return k.result(), nil
+}
+
+// SumKahan 使用 Kahan（Neumaier 改进版）补偿求和。
+// 相比 Sum 直接累加，在切片很长或者数量级差距很大的时候，累积的舍入误差要小得多。
+// NaN 和无穷大的处理和直接累加一致。
+// 对于 nil 或空切片，返回 0。
+func SumKahan[T arktools.Float](ts []T) T {
+	var k kahan[T]
+	for _, t := range ts {
+		k.add(t)
+	}
+	return k.result()
+}
+
+// pairwiseBlockSize 元素个数不超过这个值的时候直接累加
+const pairwiseBlockSize = 128
+
+// SumPairwise 使用两两分治求和。
+// 舍入误差随元素个数按照 O(log n) 增长，而直接累加是 O(n)；速度比 SumKahan 更快，精度略差。
+// 对于 nil 或空切片，返回 0。
+func SumPairwise[T arktools.Float](ts []T) T {
+	if len(ts) <= pairwiseBlockSize {
+		var res T
+		for _, t := range ts {
+			res += t
+		}
+		return res
+	}
+	mid := len(ts) / 2
+	return SumPairwise[T](ts[:mid]) + SumPairwise[T](ts[mid:])
+}
+
+// kahan 是 Neumaier 改进版的 Kahan 补偿累加器
+type kahan[T arktools.Float] struct {
+	sum T
+	// c 是被舍入掉的低位部分
+	c T
+}
+
+func (k *kahan[T]) add(t T) {
+	s := k.sum + t
+	if math.Abs(float64(k.sum)) >= math.Abs(float64(t)) {
+		k.c += (k.sum - s) + t
+	} else {
+		k.c += (t - s) + k.sum
+	}
+	k.sum = s
+}
+
+func (k *kahan[T]) result() T {
+	// 出现无穷大或者 NaN 之后补偿值已经没有意义，而且可能是 NaN
+	if math.IsInf(float64(k.sum), 0) || isNaN(k.sum) {
+		return k.sum
+	}
+	return k.sum + k.c
+}
+
+func isNaN[T arktools.Float](t T) bool {
+	return t != t
+}
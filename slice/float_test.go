@@ -0,0 +1,200 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxFloatAndMinFloat(t *testing.T) {
+	nan := math.NaN()
+	testCases := []struct {
+		name       string
+		input      []float64
+		policy     NaNPolicy
+		wantMax    float64
+		wantMin    float64
+		wantNaN    bool
+		wantErr    error
+		wantMaxErr error
+	}{
+		{
+			name:    "nil",
+			policy:  NaNSkip,
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "no NaN",
+			input:   []float64{2, 3, 1},
+			policy:  NaNPropagate,
+			wantMax: 3,
+			wantMin: 1,
+		},
+		{
+			name:    "infinity",
+			input:   []float64{2, math.Inf(1), math.Inf(-1)},
+			policy:  NaNError,
+			wantMax: math.Inf(1),
+			wantMin: math.Inf(-1),
+		},
+		{
+			name:    "propagate NaN at index 0",
+			input:   []float64{nan, 3, 1},
+			policy:  NaNPropagate,
+			wantNaN: true,
+		},
+		{
+			name:    "propagate NaN in the middle",
+			input:   []float64{2, nan, 1},
+			policy:  NaNPropagate,
+			wantNaN: true,
+		},
+		{
+			name:    "skip NaN",
+			input:   []float64{nan, 3, nan, 1},
+			policy:  NaNSkip,
+			wantMax: 3,
+			wantMin: 1,
+		},
+		{
+			name:    "skip all NaN",
+			input:   []float64{nan, nan},
+			policy:  NaNSkip,
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "error on NaN",
+			input:   []float64{2, nan, 1},
+			policy:  NaNError,
+			wantErr: errs.NewErrNaN(1),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			maxRes, err := MaxFloat(tc.input, tc.policy)
+			assert.Equal(t, tc.wantErr, err)
+			minRes, minErr := MinFloat(tc.input, tc.policy)
+			assert.Equal(t, tc.wantErr, minErr)
+			if tc.wantNaN {
+				assert.True(t, math.IsNaN(maxRes))
+				assert.True(t, math.IsNaN(minRes))
+				return
+			}
+			assert.Equal(t, tc.wantMax, maxRes)
+			assert.Equal(t, tc.wantMin, minRes)
+		})
+	}
+}
+
+func TestSumFloat(t *testing.T) {
+	nan := float32(math.NaN())
+	testCases := []struct {
+		name    string
+		input   []float32
+		policy  NaNPolicy
+		want    float32
+		wantNaN bool
+		wantErr error
+	}{
+		{
+			name:   "nil",
+			policy: NaNError,
+		},
+		{
+			name:   "no NaN",
+			input:  []float32{1, 2, 3.5},
+			policy: NaNError,
+			want:   6.5,
+		},
+		{
+			name:    "propagate",
+			input:   []float32{1, nan, 3},
+			policy:  NaNPropagate,
+			wantNaN: true,
+		},
+		{
+			name:   "skip",
+			input:  []float32{1, nan, 3},
+			policy: NaNSkip,
+			want:   4,
+		},
+		{
+			name:    "error",
+			input:   []float32{1, 2, nan},
+			policy:  NaNError,
+			wantErr: errs.NewErrNaN(2),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := SumFloat(tc.input, tc.policy)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantNaN {
+				assert.True(t, isNaN(res))
+				return
+			}
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestSumKahanAndSumPairwise(t *testing.T) {
+	// 0.1 无法被精确表示，直接累加一百万次会积累明显的误差
+	ts := make([]float64, 1_000_000)
+	for i := range ts {
+		ts[i] = 0.1
+	}
+	naive := Sum(ts)
+	kahanRes := SumKahan(ts)
+	pairwise := SumPairwise(ts)
+	assert.Greater(t, math.Abs(naive-100_000), 1e-7)
+	assert.InDelta(t, 100_000, kahanRes, 1e-9)
+	assert.InDelta(t, 100_000, pairwise, 1e-9)
+	assert.Less(t, math.Abs(kahanRes-100_000), math.Abs(naive-100_000))
+	assert.Less(t, math.Abs(pairwise-100_000), math.Abs(naive-100_000))
+
+	// 数量级差距很大的时候，Neumaier 改进版依旧能保留小的部分
+	assert.Equal(t, 2.0, SumKahan([]float64{1, 1e100, 1, -1e100}))
+
+	assert.Equal(t, float32(0), SumKahan[float32](nil))
+	assert.Equal(t, float32(0), SumPairwise[float32](nil))
+	assert.True(t, math.IsInf(SumKahan([]float64{1, math.Inf(1), 2}), 1))
+	assert.True(t, math.IsNaN(SumKahan([]float64{math.Inf(1), math.Inf(-1)})))
+	assert.True(t, math.IsNaN(SumPairwise([]float64{1, math.NaN()})))
+}
+
+func ExampleSumKahan() {
+	fmt.Println(Sum([]float64{1, 1e100, 1, -1e100}))
+	fmt.Println(SumKahan([]float64{1, 1e100, 1, -1e100}))
+	// Output:
+	// 0
+	// 2
+}
+
+func ExampleMaxFloat() {
+	src := []float64{1, math.NaN(), 3}
+	res, _ := MaxFloat(src, NaNSkip)
+	fmt.Println(res)
+	_, err := MaxFloat(src, NaNError)
+	fmt.Println(err)
+	// Output:
+	// 3
+	// arktools: 下标 1 处的值为 NaN
+}
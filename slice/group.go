@@ -0,0 +1,95 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+// GroupBy 按照 key 将 []Ele 分组
+// 从 Ele 中提取 Key 的函数 fn 由使用者提供
+// 和 ToMap 不同的是，key 相同的元素不会互相覆盖，而是按照在 elements 中的顺序放入同一组
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func GroupBy[Ele any, Key comparable](
+	elements []Ele,
+	fn func(element Ele) Key,
+) map[Key][]Ele {
+	return GroupByV(
+		elements,
+		func(element Ele) (Key, Ele) {
+			return fn(element), element
+		})
+}
+
+// GroupByV 按照 key 将 []Ele 分组，并将每一个元素转化为 Val
+// 从 Ele 中提取 Key 和 Val 的函数 fn 由使用者提供
+// 组内 Val 的顺序和元素在 elements 中的顺序一致
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func GroupByV[Ele any, Key comparable, Val any](
+	elements []Ele,
+	fn func(element Ele) (Key, Val),
+) map[Key][]Val {
+	resultMap := make(map[Key][]Val)
+	for _, element := range elements {
+		k, v := fn(element)
+		resultMap[k] = append(resultMap[k], v)
+	}
+	return resultMap
+}
+
+// GroupByOrdered 和 GroupBy 一样按照 key 将 []Ele 分组
+// 额外返回所有的 key，key 的顺序是它第一次出现在 elements 中的顺序
+// 遍历 keys 就可以按照稳定的顺序访问 groups，而不受 map 遍历顺序的影响
+// 即使传入的切片为 nil，也保证返回的是空切片和空 map 而不是 nil
+func GroupByOrdered[Ele any, Key comparable](
+	elements []Ele,
+	fn func(element Ele) Key,
+) (keys []Key, groups map[Key][]Ele) {
+	keys = make([]Key, 0)
+	groups = make(map[Key][]Ele)
+	for _, element := range elements {
+		k := fn(element)
+		group, ok := groups[k]
+		if !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(group, element)
+	}
+	return
+}
+
+// Partition 将 src 按照 match 的结果分成两部分
+// yes 是 match 返回 true 的元素，no 是 match 返回 false 的元素，两者都保持元素原本的顺序
+// 即使传入的切片为 nil，也保证返回的是空切片而不是 nil
+func Partition[T any](src []T, match matchFunc[T]) (yes []T, no []T) {
+	yes, no = make([]T, 0), make([]T, 0)
+	for _, s := range src {
+		if match(s) {
+			yes = append(yes, s)
+		} else {
+			no = append(no, s)
+		}
+	}
+	return
+}
+
+// CountBy 按照 key 统计 []Ele 中每一组元素的个数
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func CountBy[Ele any, Key comparable](
+	elements []Ele,
+	fn func(element Ele) Key,
+) map[Key]int {
+	resultMap := make(map[Key]int)
+	for _, element := range elements {
+		resultMap[fn(element)]++
+	}
+	return resultMap
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		elements []string
+		want     map[int][]string
+	}{
+		{
+			name: "nil",
+			want: map[int][]string{},
+		},
+		{
+			name:     "empty",
+			elements: []string{},
+			want:     map[int][]string{},
+		},
+		{
+			name:     "groups",
+			elements: []string{"a", "bb", "c", "dd", "eee"},
+			want: map[int][]string{
+				1: {"a", "c"},
+				2: {"bb", "dd"},
+				3: {"eee"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := GroupBy(tc.elements, func(element string) int {
+				return len(element)
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestGroupByV(t *testing.T) {
+	type user struct {
+		city string
+		name string
+	}
+	res := GroupByV([]user{
+		{city: "beijing", name: "a"},
+		{city: "shanghai", name: "b"},
+		{city: "beijing", name: "c"},
+	}, func(u user) (string, string) {
+		return u.city, u.name
+	})
+	assert.Equal(t, map[string][]string{
+		"beijing":  {"a", "c"},
+		"shanghai": {"b"},
+	}, res)
+	assert.Equal(t, map[string][]string{}, GroupByV(nil, func(u user) (string, string) {
+		return u.city, u.name
+	}))
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	testCases := []struct {
+		name       string
+		elements   []string
+		wantKeys   []int
+		wantGroups map[int][]string
+	}{
+		{
+			name:       "nil",
+			wantKeys:   []int{},
+			wantGroups: map[int][]string{},
+		},
+		{
+			name:     "groups",
+			elements: []string{"bb", "a", "eee", "c", "dd"},
+			wantKeys: []int{2, 1, 3},
+			wantGroups: map[int][]string{
+				1: {"a", "c"},
+				2: {"bb", "dd"},
+				3: {"eee"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keys, groups := GroupByOrdered(tc.elements, func(element string) int {
+				return len(element)
+			})
+			assert.Equal(t, tc.wantKeys, keys)
+			assert.Equal(t, tc.wantGroups, groups)
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		wantYes []int
+		wantNo  []int
+	}{
+		{
+			name:    "nil",
+			wantYes: []int{},
+			wantNo:  []int{},
+		},
+		{
+			name:    "all yes",
+			src:     []int{2, 4},
+			wantYes: []int{2, 4},
+			wantNo:  []int{},
+		},
+		{
+			name:    "mixed",
+			src:     []int{1, 2, 3, 4, 5},
+			wantYes: []int{2, 4},
+			wantNo:  []int{1, 3, 5},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			yes, no := Partition(tc.src, func(src int) bool {
+				return src%2 == 0
+			})
+			assert.Equal(t, tc.wantYes, yes)
+			assert.Equal(t, tc.wantNo, no)
+		})
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		elements []string
+		want     map[int]int
+	}{
+		{
+			name: "nil",
+			want: map[int]int{},
+		},
+		{
+			name:     "count",
+			elements: []string{"a", "bb", "c", "dd", "eee"},
+			want:     map[int]int{1: 2, 2: 2, 3: 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := CountBy(tc.elements, func(element string) int {
+				return len(element)
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func ExampleGroupBy() {
+	res := GroupBy([]int{1, 2, 3, 4, 5}, func(element int) bool {
+		return element%2 == 0
+	})
+	fmt.Println(res)
+	// Output: map[false:[1 3 5] true:[2 4]]
+}
+
+func ExampleGroupByOrdered() {
+	keys, groups := GroupByOrdered([]string{"banana", "apple", "blueberry", "avocado"}, func(element string) byte {
+		return element[0]
+	})
+	for _, k := range keys {
+		fmt.Println(string(k), groups[k])
+	}
+	// Output:
+	// b [banana blueberry]
+	// a [apple avocado]
+}
+
+func ExamplePartition() {
+	yes, no := Partition([]int{1, 2, 3, 4, 5}, func(src int) bool {
+		return src > 2
+	})
+	fmt.Println(yes, no)
+	// Output: [3 4 5] [1 2]
+}
+
+func ExampleCountBy() {
+	res := CountBy([]string{"go", "java", "go", "rust"}, func(element string) string {
+		return element
+	})
+	fmt.Println(res)
+	// Output: map[go:2 java:1 rust:1]
+}
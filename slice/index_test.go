@@ -0,0 +1,147 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		dst      int
+		want     int
+		wantLast int
+		wantAll  []int
+	}{
+		{
+			name:     "nil",
+			dst:      1,
+			want:     -1,
+			wantLast: -1,
+			wantAll:  []int{},
+		},
+		{
+			name:     "empty",
+			src:      []int{},
+			dst:      1,
+			want:     -1,
+			wantLast: -1,
+			wantAll:  []int{},
+		},
+		{
+			name:     "not found",
+			src:      []int{1, 2, 3},
+			dst:      4,
+			want:     -1,
+			wantLast: -1,
+			wantAll:  []int{},
+		},
+		{
+			name:     "found once",
+			src:      []int{1, 2, 3},
+			dst:      2,
+			want:     1,
+			wantLast: 1,
+			wantAll:  []int{1},
+		},
+		{
+			name:     "found many times",
+			src:      []int{2, 1, 2, 3, 2},
+			dst:      2,
+			want:     0,
+			wantLast: 4,
+			wantAll:  []int{0, 2, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Index(tc.src, tc.dst))
+			assert.Equal(t, tc.wantLast, LastIndex(tc.src, tc.dst))
+			assert.Equal(t, tc.wantAll, IndexAll(tc.src, tc.dst))
+		})
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	alice := testutil.Person{Name: "Alice", Age: 30}
+	bob := testutil.Person{Name: "Bob", Age: 25}
+	david := testutil.Person{Name: "David", Age: 40}
+
+	testCases := []struct {
+		name     string
+		src      []testutil.Person
+		match    func(p testutil.Person) bool
+		want     int
+		wantLast int
+		wantAll  []int
+	}{
+		{
+			name:     "nil",
+			match:    func(p testutil.Person) bool { return true },
+			want:     -1,
+			wantLast: -1,
+			wantAll:  []int{},
+		},
+		{
+			name:     "not found",
+			src:      []testutil.Person{alice, bob},
+			match:    func(p testutil.Person) bool { return p.Age > 50 },
+			want:     -1,
+			wantLast: -1,
+			wantAll:  []int{},
+		},
+		{
+			name:     "found many times",
+			src:      []testutil.Person{bob, alice, david, bob},
+			match:    func(p testutil.Person) bool { return p.Age >= 30 },
+			want:     1,
+			wantLast: 2,
+			wantAll:  []int{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IndexFunc(tc.src, tc.match))
+			assert.Equal(t, tc.wantLast, LastIndexFunc(tc.src, tc.match))
+			assert.Equal(t, tc.wantAll, IndexAllFunc(tc.src, tc.match))
+		})
+	}
+}
+
+func ExampleIndexFunc() {
+	people := []testutil.Person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}
+	idx := IndexFunc(people, func(p testutil.Person) bool {
+		return p.Name == "Bob"
+	})
+	people, _ = Delete(people, idx)
+	fmt.Println(idx, people)
+	// Output:
+	// 1 [{Alice 30}]
+}
+
+func ExampleIndexAll() {
+	res := IndexAll([]int{2, 1, 2, 3, 2}, 2)
+	fmt.Println(res)
+	// Output:
+	// [0 2 4]
+}
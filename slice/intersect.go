@@ -0,0 +1,45 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+// IntersectSet 交集，只支持 comparable
+// 已去重
+// 返回值的元素顺序和它们在 src 中第一次出现的顺序一致
+func IntersectSet[T comparable](src, dst []T) []T {
+	dstMap := toMap[T](dst)
+	var ret = make([]T, 0, min(len(src), len(dst)))
+	for _, v := range src {
+		if _, exist := dstMap[v]; exist {
+			ret = append(ret, v)
+		}
+	}
+	return Deduplicate[T](ret)
+}
+
+// IntersectSetFunc 交集，支持任意类型
+// 你应该优先使用 IntersectSet
+// 已去重
+// 返回值的元素顺序和 IntersectSet 一致
+func IntersectSetFunc[T any](src, dst []T, equal equalFunc[T]) []T {
+	var ret = make([]T, 0, min(len(src), len(dst)))
+	for _, valSrc := range src {
+		if ContainsFunc[T](dst, func(valDst T) bool {
+			return equal(valSrc, valDst)
+		}) {
+			ret = append(ret, valSrc)
+		}
+	}
+	return DeduplicateFunc[T](ret, equal)
+}
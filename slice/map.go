@@ -14,6 +14,8 @@
 
 package slice
 
+import "github.com/hanleilei/arktools/internal/errs"
+
 // FilterMap 执行过滤并且转化
 // 如果 m 的第二个返回值是 false，那么我们会忽略第一个返回值
 // 即便第二个返回值是 false，后续的元素依旧会被遍历
@@ -87,33 +89,64 @@ func ToMapV[Ele any, Key comparable, Val any](
 	return
 }
 
-// 构造map
-func toMap[T comparable](src []T) map[T]struct{} {
-	var dataMap = make(map[T]struct{}, len(src))
-	for _, v := range src {
-		// 使用空结构体,减少内存消耗
-		dataMap[v] = struct{}{}
+// ToMapE 和 ToMap 一样将 []Ele 映射到 map[Key]Ele
+// 但是出现重复的 key 时不会覆盖，而是返回 errs.NewErrDuplicateKey，其中的下标是第二次出现该 key 的元素
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func ToMapE[Ele any, Key comparable](
+	elements []Ele,
+	fn func(element Ele) Key,
+) (map[Key]Ele, error) {
+	return ToMapVE(
+		elements,
+		func(element Ele) (Key, Ele) {
+			return fn(element), element
+		})
+}
+
+// ToMapVE 和 ToMapV 一样将 []Ele 映射到 map[Key]Val
+// 但是出现重复的 key 时不会覆盖，而是返回 errs.NewErrDuplicateKey，其中的下标是第二次出现该 key 的元素
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func ToMapVE[Ele any, Key comparable, Val any](
+	elements []Ele,
+	fn func(element Ele) (Key, Val),
+) (map[Key]Val, error) {
+	resultMap := make(map[Key]Val, len(elements))
+	for i, element := range elements {
+		k, v := fn(element)
+		if _, ok := resultMap[k]; ok {
+			return nil, errs.NewErrDuplicateKey(k, i)
+		}
+		resultMap[k] = v
 	}
-	return dataMap
+	return resultMap, nil
 }
 
-func deduplicateFunc[T any](data []T, equal equalFunc[T]) []T {
-	var newData = make([]T, 0, len(data))
-	for k, v := range data {
-		if !ContainsFunc[T](data[k+1:], func(src T) bool {
-			return equal(src, v)
-		}) {
-			newData = append(newData, v)
+// ToMapVMerge 和 ToMapV 一样将 []Ele 映射到 map[Key]Val
+// 出现重复的 key 时，使用 merge 合并已有的值 old 和新的值 val，合并的结果作为该 key 的值
+// 当 merge 直接返回 val 时，效果和 ToMapV 一致
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func ToMapVMerge[Ele any, Key comparable, Val any](
+	elements []Ele,
+	fn func(element Ele) (Key, Val),
+	merge func(key Key, old Val, val Val) Val,
+) map[Key]Val {
+	resultMap := make(map[Key]Val, len(elements))
+	for _, element := range elements {
+		k, v := fn(element)
+		if old, ok := resultMap[k]; ok {
+			v = merge(k, old, v)
 		}
+		resultMap[k] = v
 	}
-	return newData
+	return resultMap
 }
 
-func deduplicate[T comparable](data []T) []T {
-	dataMap := toMap[T](data)
-	var newData = make([]T, 0, len(dataMap))
-	for key := range dataMap {
-		newData = append(newData, key)
+// 构造map
+func toMap[T comparable](src []T) map[T]struct{} {
+	var dataMap = make(map[T]struct{}, len(src))
+	for _, v := range src {
+		// 使用空结构体,减少内存消耗
+		dataMap[v] = struct{}{}
 	}
-	return newData
+	return dataMap
 }
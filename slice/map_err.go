@@ -0,0 +1,94 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"errors"
+
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+// ErrorPolicy 决定 MapErr 等方法在转化某一个元素失败时如何处理
+type ErrorPolicy int
+
+const (
+	// ErrorFailFast 遇到第一个错误就停止，后续的元素不会再被转化
+	ErrorFailFast ErrorPolicy = iota
+	// ErrorCollectAll 转化所有的元素，返回转化成功的部分，所有的错误通过 errors.Join 合并返回
+	ErrorCollectAll
+)
+
+// MapErr 和 Map 一样将 []Src 转化为 []Dst，但是 m 可以返回错误
+// 返回的每一个错误都被包装为 errs.NewErrElement，可以通过 errors.As 拿到失败的元素的下标，
+// 也可以通过 errors.Is 匹配 m 返回的原始错误
+// 在 ErrorFailFast 下，出现错误时返回 nil 和该错误；
+// 在 ErrorCollectAll 下，返回转化成功的元素（保持原本的顺序）和按照下标顺序合并的所有错误
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func MapErr[Src any, Dst any](src []Src, m func(idx int, src Src) (Dst, error), policy ErrorPolicy) ([]Dst, error) {
+	return FilterMapErr(src, func(idx int, src Src) (Dst, bool, error) {
+		dst, err := m(idx, src)
+		return dst, true, err
+	}, policy)
+}
+
+// FilterMapErr 和 FilterMap 一样执行过滤并且转化，但是 m 可以返回错误
+// 如果 m 返回了错误，那么前两个返回值都会被忽略
+// 错误的处理方式和 MapErr 一致
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func FilterMapErr[Src any, Dst any](src []Src, m func(idx int, src Src) (Dst, bool, error), policy ErrorPolicy) ([]Dst, error) {
+	res := make([]Dst, 0, len(src))
+	var errList []error
+	for i, s := range src {
+		dst, ok, err := m(i, s)
+		if err != nil {
+			err = errs.NewErrElement(i, err)
+			if policy == ErrorFailFast {
+				return nil, err
+			}
+			errList = append(errList, err)
+			continue
+		}
+		if ok {
+			res = append(res, dst)
+		}
+	}
+	return res, errors.Join(errList...)
+}
+
+// ToMapErr 和 ToMapV 一样将 []Ele 映射到 map[Key]Val，但是 fn 可以返回错误
+// 出现重复的 key 时，后出现的值会覆盖先出现的值
+// 错误的处理方式和 MapErr 一致，在 ErrorCollectAll 下返回的 map 只包含转化成功的元素
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func ToMapErr[Ele any, Key comparable, Val any](
+	elements []Ele,
+	fn func(element Ele) (Key, Val, error),
+	policy ErrorPolicy,
+) (map[Key]Val, error) {
+	resultMap := make(map[Key]Val, len(elements))
+	var errList []error
+	for i, element := range elements {
+		k, v, err := fn(element)
+		if err != nil {
+			err = errs.NewErrElement(i, err)
+			if policy == ErrorFailFast {
+				return nil, err
+			}
+			errList = append(errList, err)
+			continue
+		}
+		resultMap[k] = v
+	}
+	return resultMap, errors.Join(errList...)
+}
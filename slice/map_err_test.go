@@ -0,0 +1,212 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	arkerrs "github.com/hanleilei/arktools/errs"
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapErr(t *testing.T) {
+	_, errA := strconv.Atoi("a")
+	_, errB := strconv.Atoi("b")
+	testCases := []struct {
+		name    string
+		src     []string
+		policy  ErrorPolicy
+		want    []int
+		wantErr error
+	}{
+		{
+			name:   "nil",
+			policy: ErrorFailFast,
+			want:   []int{},
+		},
+		{
+			name:   "success",
+			src:    []string{"1", "2", "3"},
+			policy: ErrorFailFast,
+			want:   []int{1, 2, 3},
+		},
+		{
+			name:    "fail fast",
+			src:     []string{"1", "a", "3", "b"},
+			policy:  ErrorFailFast,
+			wantErr: errs.NewErrElement(1, errA),
+		},
+		{
+			name:    "collect all",
+			src:     []string{"1", "a", "3", "b"},
+			policy:  ErrorCollectAll,
+			want:    []int{1, 3},
+			wantErr: errors.Join(errs.NewErrElement(1, errA), errs.NewErrElement(3, errB)),
+		},
+		{
+			name:   "collect all without error",
+			src:    []string{"1", "2"},
+			policy: ErrorCollectAll,
+			want:   []int{1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := MapErr(tc.src, func(idx int, src string) (int, error) {
+				return strconv.Atoi(src)
+			}, tc.policy)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestMapErr_FailFastStops(t *testing.T) {
+	bizErr := errors.New("biz error")
+	calls := 0
+	_, err := MapErr([]int{1, 2, 3}, func(idx int, src int) (int, error) {
+		calls++
+		if src == 2 {
+			return 0, bizErr
+		}
+		return src, nil
+	}, ErrorFailFast)
+	assert.Equal(t, 2, calls)
+	assert.ErrorIs(t, err, bizErr)
+	assert.ErrorIs(t, err, arkerrs.ErrElementFailed)
+	var elemErr *arkerrs.ElementError
+	assert.True(t, errors.As(err, &elemErr))
+	assert.Equal(t, 1, elemErr.Index)
+}
+
+func TestFilterMapErr(t *testing.T) {
+	bizErr := errors.New("biz error")
+	m := func(idx int, src int) (string, bool, error) {
+		if src < 0 {
+			return "", false, bizErr
+		}
+		return strconv.Itoa(src), src%2 == 0, nil
+	}
+	testCases := []struct {
+		name    string
+		src     []int
+		policy  ErrorPolicy
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "nil",
+			policy: ErrorCollectAll,
+			want:   []string{},
+		},
+		{
+			name:   "filter",
+			src:    []int{1, 2, 3, 4},
+			policy: ErrorFailFast,
+			want:   []string{"2", "4"},
+		},
+		{
+			name:    "fail fast",
+			src:     []int{2, -1, 4},
+			policy:  ErrorFailFast,
+			wantErr: errs.NewErrElement(1, bizErr),
+		},
+		{
+			name:    "collect all",
+			src:     []int{2, -1, 3, 4, -2},
+			policy:  ErrorCollectAll,
+			want:    []string{"2", "4"},
+			wantErr: errors.Join(errs.NewErrElement(1, bizErr), errs.NewErrElement(4, bizErr)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := FilterMapErr(tc.src, m, tc.policy)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestToMapErr(t *testing.T) {
+	_, errA := strconv.Atoi("a")
+	testCases := []struct {
+		name     string
+		elements []string
+		policy   ErrorPolicy
+		want     map[string]int
+		wantErr  error
+	}{
+		{
+			name:   "nil",
+			policy: ErrorFailFast,
+			want:   map[string]int{},
+		},
+		{
+			name:     "success",
+			elements: []string{"1", "2", "1"},
+			policy:   ErrorFailFast,
+			want:     map[string]int{"1": 1, "2": 2},
+		},
+		{
+			name:     "fail fast",
+			elements: []string{"1", "a", "2"},
+			policy:   ErrorFailFast,
+			wantErr:  errs.NewErrElement(1, errA),
+		},
+		{
+			name:     "collect all",
+			elements: []string{"1", "a", "2"},
+			policy:   ErrorCollectAll,
+			want:     map[string]int{"1": 1, "2": 2},
+			wantErr:  errors.Join(errs.NewErrElement(1, errA)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ToMapErr(tc.elements, func(element string) (string, int, error) {
+				num, err := strconv.Atoi(element)
+				return element, num, err
+			}, tc.policy)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func ExampleMapErr() {
+	src := []string{"1", "a", "3"}
+	_, err := MapErr(src, func(idx int, src string) (int, error) {
+		return strconv.Atoi(src)
+	}, ErrorFailFast)
+	fmt.Println(err)
+
+	res, err := MapErr(src, func(idx int, src string) (int, error) {
+		return strconv.Atoi(src)
+	}, ErrorCollectAll)
+	fmt.Println(res)
+	var elemErr *arkerrs.ElementError
+	if errors.As(err, &elemErr) {
+		fmt.Println(elemErr.Index)
+	}
+	// Output:
+	// arktools: 处理下标 1 处的元素失败: strconv.Atoi: parsing "a": invalid syntax
+	// [1 3]
+	// 1
+}
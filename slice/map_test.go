@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hanleilei/arktools/internal/errs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -305,6 +306,109 @@ func TestToMap(t *testing.T) {
 	})
 }
 
+func TestToMapE(t *testing.T) {
+	testCases := []struct {
+		name     string
+		elements []string
+		want     map[int]string
+		wantErr  error
+	}{
+		{
+			name: "nil",
+			want: map[int]string{},
+		},
+		{
+			name:     "unique",
+			elements: []string{"1", "2", "3"},
+			want:     map[int]string{1: "1", 2: "2", 3: "3"},
+		},
+		{
+			name:     "duplicate",
+			elements: []string{"1", "2", "01", "2"},
+			wantErr:  errs.NewErrDuplicateKey(1, 2),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ToMapE(tc.elements, func(str string) int {
+				num, _ := strconv.Atoi(str)
+				return num
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestToMapVE(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	res, err := ToMapVE([]user{{1, "a"}, {2, "b"}}, func(u user) (int, string) {
+		return u.id, u.name
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, res)
+
+	_, err = ToMapVE([]user{{1, "a"}, {1, "b"}}, func(u user) (int, string) {
+		return u.id, u.name
+	})
+	assert.Equal(t, errs.NewErrDuplicateKey(1, 1), err)
+}
+
+func TestToMapVMerge(t *testing.T) {
+	testCases := []struct {
+		name     string
+		elements []string
+		want     map[int]string
+	}{
+		{
+			name: "nil",
+			want: map[int]string{},
+		},
+		{
+			name:     "unique",
+			elements: []string{"1", "22"},
+			want:     map[int]string{1: "1", 2: "22"},
+		},
+		{
+			name:     "merge",
+			elements: []string{"1", "22", "3", "44", "5"},
+			want:     map[int]string{1: "1,3,5", 2: "22,44"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ToMapVMerge(tc.elements, func(str string) (int, string) {
+				return len(str), str
+			}, func(key int, old string, val string) string {
+				return old + "," + val
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func ExampleToMapE() {
+	_, err := ToMapE([]string{"a", "b", "a"}, func(str string) string {
+		return str
+	})
+	fmt.Println(err)
+	// Output: arktools: 重复的 key a, 下标 2
+}
+
+func ExampleToMapVMerge() {
+	words := []string{"go", "java", "go", "rust", "go"}
+	res := ToMapVMerge(words, func(word string) (string, int) {
+		return word, 1
+	}, func(key string, old int, val int) int {
+		return old + val
+	})
+	fmt.Println(res)
+	// Output: map[go:3 java:1 rust:1]
+}
+
 func ExampleToMap() {
 	elements := []string{"1", "2", "3", "4", "5"}
 	resMap := ToMap(elements, func(str string) int {
@@ -0,0 +1,26 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"github.com/hanleilei/arktools/internal/slice"
+)
+
+// Move 将 from 处的元素移动到 to 处，两者之间的元素依次挪动一个位置
+// 所有操作都会在原切片上进行
+// 如果 from 或者 to 超出范围（< 0 或 >= len(src)），返回错误
+func Move[Src any](src []Src, from, to int) error {
+	return slice.Move[Src](src, from, to)
+}
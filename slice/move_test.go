@@ -0,0 +1,75 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMove(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slice     []string
+		from      int
+		to        int
+		wantSlice []string
+		wantErr   error
+	}{
+		{
+			name:      "move forward",
+			slice:     []string{"a", "b", "c", "d"},
+			from:      0,
+			to:        2,
+			wantSlice: []string{"b", "c", "a", "d"},
+		},
+		{
+			name:      "move backward",
+			slice:     []string{"a", "b", "c", "d"},
+			from:      3,
+			to:        1,
+			wantSlice: []string{"a", "d", "b", "c"},
+		},
+		{
+			name:    "index out of range",
+			slice:   []string{"a", "b"},
+			from:    0,
+			to:      2,
+			wantErr: errs.NewErrIndexOutOfRange(2, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Move(tc.slice, tc.from, tc.to)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
+
+func ExampleMove() {
+	src := []int{1, 2, 3, 4}
+	_ = Move(src, 3, 0)
+	fmt.Println(src)
+	// Output:
+	// [4 1 2 3]
+}
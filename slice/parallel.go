@@ -0,0 +1,160 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"golang.org/x/sync/errgroup"
+)
+
+// ParallelOption 是 ParallelMap、ParallelFilterMap 和 ParallelForEach 的选项
+type ParallelOption func(o *parallelOptions)
+
+type parallelOptions struct {
+	concurrency int
+	policy      ErrorPolicy
+}
+
+// WithConcurrency 设置同时执行的 goroutine 数量上限
+// 默认是 runtime.GOMAXPROCS(0)；小于等于 0 表示不限制，每一个元素都会启动一个 goroutine
+func WithConcurrency(concurrency int) ParallelOption {
+	return func(o *parallelOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// WithErrorPolicy 设置出现错误时的处理方式，默认是 ErrorFailFast，含义和 MapErr 一致
+// ErrorFailFast 下第一个错误会取消 ctx，尚未开始的元素不会再执行；
+// ErrorCollectAll 下一个元素失败不会影响其它元素，所有的错误按照下标的顺序通过 errors.Join 合并返回
+func WithErrorPolicy(policy ErrorPolicy) ParallelOption {
+	return func(o *parallelOptions) {
+		o.policy = policy
+	}
+}
+
+// ParallelForEach 并发地对 src 中的每一个元素执行 fn
+// fn 返回的错误都被包装为 errs.NewErrElement，可以通过 errors.As 拿到失败的元素的下标
+// fn 收到的 ctx 会在传入的 ctx 被取消的时候被取消，ErrorFailFast 下第一个错误出现的时候也会被取消，耗时的 fn 应当监听它
+// ctx 被取消之后，尚未开始的元素不会再执行；如果是传入的 ctx 被取消，返回的错误中包含 ctx.Err()
+// 错误的处理方式由 WithErrorPolicy 决定
+func ParallelForEach[Src any](ctx context.Context, src []Src,
+	fn func(ctx context.Context, idx int, src Src) error, opts ...ParallelOption) error {
+	return parallelForEach(ctx, src, fn, newParallelOptions(opts))
+}
+
+func newParallelOptions(opts []ParallelOption) parallelOptions {
+	o := parallelOptions{concurrency: runtime.GOMAXPROCS(0), policy: ErrorFailFast}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.concurrency <= 0 {
+		o.concurrency = -1
+	}
+	return o
+}
+
+func parallelForEach[Src any](ctx context.Context, src []Src,
+	fn func(ctx context.Context, idx int, src Src) error, o parallelOptions) error {
+	if o.policy == ErrorFailFast {
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(o.concurrency)
+		for i, s := range src {
+			if gctx.Err() != nil {
+				break
+			}
+			// 达到并发上限时 g.Go 会阻塞，等到它返回的时候 gctx 可能已经被取消
+			g.Go(func() error {
+				if gctx.Err() != nil {
+					return nil
+				}
+				if err := fn(gctx, i, s); err != nil {
+					return errs.NewErrElement(i, err)
+				}
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		return ctx.Err()
+	}
+
+	var g errgroup.Group
+	g.SetLimit(o.concurrency)
+	errList := make([]error, len(src))
+	for i, s := range src {
+		if ctx.Err() != nil {
+			break
+		}
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if err := fn(ctx, i, s); err != nil {
+				errList[i] = errs.NewErrElement(i, err)
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return errors.Join(append(errList, ctx.Err())...)
+}
+
+// ParallelMap 并发地将 src 中的每一个元素转化为 Dst，结果的顺序和 src 保持一致
+// 错误的处理方式以及 ctx 的含义和 ParallelForEach 一致，返回值的含义和 MapErr 一致：
+// ErrorFailFast 下出现错误时返回 nil 和错误；
+// ErrorCollectAll 下返回转化成功的元素（保持原本的顺序）和合并之后的错误
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func ParallelMap[Src any, Dst any](ctx context.Context, src []Src,
+	m func(ctx context.Context, idx int, src Src) (Dst, error), opts ...ParallelOption) ([]Dst, error) {
+	return ParallelFilterMap(ctx, src, func(ctx context.Context, idx int, src Src) (Dst, bool, error) {
+		dst, err := m(ctx, idx, src)
+		return dst, true, err
+	}, opts...)
+}
+
+// ParallelFilterMap 并发地执行过滤并且转化，结果的顺序和 src 保持一致
+// 如果 m 的第二个返回值是 false，那么我们会忽略第一个返回值
+// 错误的处理方式和返回值的含义和 ParallelMap 一致
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func ParallelFilterMap[Src any, Dst any](ctx context.Context, src []Src,
+	m func(ctx context.Context, idx int, src Src) (Dst, bool, error), opts ...ParallelOption) ([]Dst, error) {
+	dsts := make([]Dst, len(src))
+	// oks[i] 为 true 表示 src[i] 转化成功并且需要保留
+	oks := make([]bool, len(src))
+	o := newParallelOptions(opts)
+	err := parallelForEach(ctx, src, func(ctx context.Context, idx int, src Src) error {
+		dst, ok, err := m(ctx, idx, src)
+		if err != nil {
+			return err
+		}
+		dsts[idx], oks[idx] = dst, ok
+		return nil
+	}, o)
+	if err != nil && o.policy == ErrorFailFast {
+		return nil, err
+	}
+	res := make([]Dst, 0, len(src))
+	for i, ok := range oks {
+		if ok {
+			res = append(res, dsts[i])
+		}
+	}
+	return res, err
+}
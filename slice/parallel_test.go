@@ -0,0 +1,241 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMap(t *testing.T) {
+	_, errA := strconv.Atoi("a")
+	_, errB := strconv.Atoi("b")
+	testCases := []struct {
+		name    string
+		src     []string
+		opts    []ParallelOption
+		want    []int
+		wantErr error
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "keep order",
+			src:  []string{"5", "4", "3", "2", "1"},
+			opts: []ParallelOption{WithConcurrency(2)},
+			want: []int{5, 4, 3, 2, 1},
+		},
+		{
+			name: "unlimited",
+			src:  []string{"1", "2", "3"},
+			opts: []ParallelOption{WithConcurrency(0)},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "fail fast",
+			src:     []string{"1", "a", "3"},
+			wantErr: errs.NewErrElement(1, errA),
+		},
+		{
+			name:    "collect all",
+			src:     []string{"1", "a", "3", "b"},
+			opts:    []ParallelOption{WithErrorPolicy(ErrorCollectAll)},
+			want:    []int{1, 3},
+			wantErr: errors.Join(errs.NewErrElement(1, errA), errs.NewErrElement(3, errB)),
+		},
+		{
+			name: "collect all without error",
+			src:  []string{"1", "2"},
+			opts: []ParallelOption{WithErrorPolicy(ErrorCollectAll)},
+			want: []int{1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ParallelMap(context.Background(), tc.src, func(ctx context.Context, idx int, src string) (int, error) {
+				// 越靠前的元素越晚完成
+				time.Sleep(time.Duration(len(tc.src)-idx) * time.Millisecond)
+				return strconv.Atoi(src)
+			}, tc.opts...)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestParallelMap_SameAsMapErr(t *testing.T) {
+	src := []string{"1", "a", "3", "b", "5"}
+	for _, policy := range []ErrorPolicy{ErrorFailFast, ErrorCollectAll} {
+		want, wantErr := MapErr(src, func(idx int, src string) (int, error) {
+			return strconv.Atoi(src)
+		}, policy)
+		// 并发为 1 时，ErrorFailFast 遇到的第一个错误也是确定的
+		res, err := ParallelMap(context.Background(), src, func(ctx context.Context, idx int, src string) (int, error) {
+			return strconv.Atoi(src)
+		}, WithErrorPolicy(policy), WithConcurrency(1))
+		assert.Equal(t, wantErr, err)
+		assert.Equal(t, want, res)
+	}
+}
+
+func TestParallelFilterMap(t *testing.T) {
+	res, err := ParallelFilterMap(context.Background(), []int{1, 2, 3, 4, 5, 6},
+		func(ctx context.Context, idx int, src int) (string, bool, error) {
+			return strconv.Itoa(src * 10), src%2 == 0, nil
+		}, WithConcurrency(3))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"20", "40", "60"}, res)
+
+	res, err = ParallelFilterMap[int, string](context.Background(), nil,
+		func(ctx context.Context, idx int, src int) (string, bool, error) {
+			return "", true, nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, res)
+
+	bizErr := errors.New("biz error")
+	m := func(ctx context.Context, idx int, src int) (string, bool, error) {
+		if src == 2 {
+			return "", false, bizErr
+		}
+		return strconv.Itoa(src), src != 3, nil
+	}
+	res, err = ParallelFilterMap(context.Background(), []int{1, 2, 3, 4}, m)
+	assert.Equal(t, errs.NewErrElement(1, bizErr), err)
+	assert.Nil(t, res)
+
+	res, err = ParallelFilterMap(context.Background(), []int{1, 2, 3, 4}, m, WithErrorPolicy(ErrorCollectAll))
+	assert.Equal(t, errors.Join(errs.NewErrElement(1, bizErr)), err)
+	assert.Equal(t, []string{"1", "4"}, res)
+}
+
+func TestParallelForEach_Concurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	src := make([]int, 20)
+	err := ParallelForEach(context.Background(), src, func(ctx context.Context, idx int, src int) error {
+		cur := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	}, WithConcurrency(3))
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+}
+
+func TestParallelForEach_FirstErrorCancels(t *testing.T) {
+	bizErr := errors.New("biz error")
+	var executed atomic.Int32
+	src := make([]int, 100)
+	err := ParallelForEach(context.Background(), src, func(ctx context.Context, idx int, src int) error {
+		executed.Add(1)
+		if idx == 0 {
+			return bizErr
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+			return nil
+		}
+	}, WithConcurrency(2))
+	assert.Equal(t, errs.NewErrElement(0, bizErr), err)
+	// 第一个错误之后，尚未开始的元素不会再执行
+	assert.Less(t, executed.Load(), int32(100))
+}
+
+func TestParallelForEach_NoStartAfterError(t *testing.T) {
+	bizErr := errors.New("biz error")
+	var executed atomic.Int32
+	// 第二个元素在 g.Go 中等待空闲的时候，第一个元素已经失败，它不应该再执行
+	err := ParallelForEach(context.Background(), make([]int, 10), func(ctx context.Context, idx int, src int) error {
+		executed.Add(1)
+		return bizErr
+	}, WithConcurrency(1))
+	assert.Equal(t, errs.NewErrElement(0, bizErr), err)
+	assert.Equal(t, int32(1), executed.Load())
+}
+
+func TestParallelForEach_CollectAll(t *testing.T) {
+	err1, err2 := errors.New("error 1"), errors.New("error 2")
+	var executed atomic.Int32
+	err := ParallelForEach(context.Background(), []int{1, 2, 3, 4}, func(ctx context.Context, idx int, src int) error {
+		executed.Add(1)
+		switch src {
+		case 2:
+			return err1
+		case 4:
+			return err2
+		}
+		return nil
+	}, WithErrorPolicy(ErrorCollectAll), WithConcurrency(1))
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+	assert.Equal(t, int32(4), executed.Load())
+}
+
+func TestParallelForEach_ContextCanceled(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts []ParallelOption
+	}{
+		{
+			name: "first error",
+		},
+		{
+			name: "collect all",
+			opts: []ParallelOption{WithErrorPolicy(ErrorCollectAll)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			var executed atomic.Int32
+			src := make([]int, 10)
+			err := ParallelForEach(ctx, src, func(ctx context.Context, idx int, src int) error {
+				if executed.Add(1) == 2 {
+					cancel()
+				}
+				return nil
+			}, append(tc.opts, WithConcurrency(1))...)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Less(t, executed.Load(), int32(10))
+		})
+	}
+}
+
+func ExampleParallelMap() {
+	res, err := ParallelMap(context.Background(), []string{"1", "2", "3"},
+		func(ctx context.Context, idx int, src string) (int, error) {
+			return strconv.Atoi(src)
+		}, WithConcurrency(2))
+	fmt.Println(res, err)
+	// Output: [1 2 3] <nil>
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+// Shuffle 返回一个随机打乱 src 之后的新切片，不会修改 src
+// source 是随机数的来源，传入固定种子的 source（例如 rand.NewPCG(1, 2)）可以得到可复现的结果
+// source 为 nil 时使用随机的种子
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Shuffle[T any](src []T, source rand.Source) []T {
+	res := make([]T, 0, len(src))
+	res = append(res, src...)
+	ShuffleSelf(res, source)
+	return res
+}
+
+// ShuffleSelf 使用 Fisher-Yates 算法随机打乱 src
+// 所有操作都会在原切片上进行，source 的含义和 Shuffle 一致
+func ShuffleSelf[T any](src []T, source rand.Source) {
+	newRand(source).Shuffle(len(src), func(i, j int) {
+		src[i], src[j] = src[j], src[i]
+	})
+}
+
+// Sample 从 src 中不放回地随机抽取 n 个元素，每个下标最多被抽中一次
+// 返回的元素按照抽中的顺序排列，不会修改 src
+// source 的含义和 Shuffle 一致
+// 如果 n 小于等于 0，返回 errs.NewErrInvalidSize
+// 如果 n 大于 len(src)，返回 errs.NewErrTooFewElements
+func Sample[T any](src []T, n int, source rand.Source) ([]T, error) {
+	if n <= 0 {
+		return nil, errs.NewErrInvalidSize(n)
+	}
+	if n > len(src) {
+		return nil, errs.NewErrTooFewElements(n, len(src))
+	}
+	r := newRand(source)
+	// 只打乱前 n 个位置的 Fisher-Yates 算法
+	indexes := make([]int, len(src))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	res := make([]T, n)
+	for i := range res {
+		j := i + r.IntN(len(src)-i)
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+		res[i] = src[indexes[i]]
+	}
+	return res, nil
+}
+
+// SampleWithReplacement 从 src 中有放回地随机抽取 n 个元素，同一个元素可能被抽中多次
+// source 的含义和 Shuffle 一致
+// 如果 n 小于等于 0，返回 errs.NewErrInvalidSize
+// 如果 src 为空或为 nil，返回 ErrEmptySlice
+func SampleWithReplacement[T any](src []T, n int, source rand.Source) ([]T, error) {
+	if n <= 0 {
+		return nil, errs.NewErrInvalidSize(n)
+	}
+	if len(src) == 0 {
+		return nil, errs.ErrEmptySlice
+	}
+	r := newRand(source)
+	res := make([]T, n)
+	for i := range res {
+		res[i] = src[r.IntN(len(src))]
+	}
+	return res, nil
+}
+
+// SampleWeighted 按照权重从 src 中有放回地随机抽取 n 个元素
+// 每个元素被抽中的概率是它的权重除以所有权重之和，weight 用于计算元素的权重
+// 权重为 0 的元素永远不会被抽中
+// source 的含义和 Shuffle 一致
+// 如果 n 小于等于 0，返回 errs.NewErrInvalidSize
+// 如果 src 为空或为 nil，返回 ErrEmptySlice
+// 如果某个权重是负数、NaN 或者无穷大，或者所有权重之和为 0，返回 errs.NewErrInvalidArgument
+func SampleWeighted[T any](src []T, n int, weight func(idx int, src T) float64, source rand.Source) ([]T, error) {
+	if n <= 0 {
+		return nil, errs.NewErrInvalidSize(n)
+	}
+	if len(src) == 0 {
+		return nil, errs.ErrEmptySlice
+	}
+	// cumulative[i] 是前 i+1 个元素的权重之和
+	cumulative := make([]float64, len(src))
+	total, last := 0.0, 0
+	for i, s := range src {
+		w := weight(i, s)
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, errs.NewErrInvalidArgument("weight", w)
+		}
+		if w > 0 {
+			last = i
+		}
+		total += w
+		cumulative[i] = total
+	}
+	if total <= 0 || math.IsInf(total, 0) {
+		return nil, errs.NewErrInvalidArgument("total weight", total)
+	}
+	r := newRand(source)
+	res := make([]T, n)
+	for i := range res {
+		target := r.Float64() * total
+		// 第一个累计权重大于 target 的元素，权重为 0 的元素累计权重和前一个相同，不会被选中
+		idx := sort.Search(len(cumulative), func(j int) bool {
+			return cumulative[j] > target
+		})
+		// 浮点数的舍入可能导致 target 等于 total，此时选择最后一个权重大于 0 的元素
+		res[i] = src[min(idx, last)]
+	}
+	return res, nil
+}
+
+// newRand 使用 source 创建 *rand.Rand，source 为 nil 时使用随机的种子
+func newRand(source rand.Source) *rand.Rand {
+	if source == nil {
+		source = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return rand.New(source)
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffle(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	origin := slices.Clone(src)
+
+	res := Shuffle(src, rand.NewPCG(1, 2))
+	// 不会修改 src
+	assert.Equal(t, origin, src)
+	// 是 src 的一个排列
+	assert.ElementsMatch(t, src, res)
+	// 相同的 source 得到相同的结果
+	assert.Equal(t, res, Shuffle(src, rand.NewPCG(1, 2)))
+
+	assert.Equal(t, []int{}, Shuffle[int](nil, nil))
+	assert.ElementsMatch(t, src, Shuffle(src, nil))
+}
+
+func TestShuffleSelf(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	ShuffleSelf(src, rand.NewPCG(1, 2))
+	assert.Equal(t, Shuffle([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, rand.NewPCG(1, 2)), src)
+}
+
+func TestSample(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		n       int
+		wantErr error
+	}{
+		{
+			name:    "invalid n",
+			src:     []int{1, 2, 3},
+			n:       0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:    "too few elements",
+			src:     []int{1, 2, 3},
+			n:       4,
+			wantErr: errs.NewErrTooFewElements(4, 3),
+		},
+		{
+			name: "part",
+			src:  []int{1, 2, 3, 4, 5},
+			n:    3,
+		},
+		{
+			name: "all",
+			src:  []int{1, 2, 3, 4, 5},
+			n:    5,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			origin := slices.Clone(tc.src)
+			res, err := Sample(tc.src, tc.n, rand.NewPCG(3, 4))
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, origin, tc.src)
+			assert.Len(t, res, tc.n)
+			// 不放回，没有重复的元素
+			assert.Len(t, Deduplicate(res), tc.n)
+			for _, v := range res {
+				assert.Contains(t, tc.src, v)
+			}
+			again, err := Sample(tc.src, tc.n, rand.NewPCG(3, 4))
+			assert.NoError(t, err)
+			assert.Equal(t, res, again)
+		})
+	}
+}
+
+func TestSampleWithReplacement(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		n       int
+		wantErr error
+	}{
+		{
+			name:    "invalid n",
+			src:     []int{1},
+			n:       -1,
+			wantErr: errs.NewErrInvalidSize(-1),
+		},
+		{
+			name:    "empty",
+			n:       1,
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name: "more than length",
+			src:  []int{1, 2},
+			n:    10,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := SampleWithReplacement(tc.src, tc.n, rand.NewPCG(5, 6))
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Len(t, res, tc.n)
+			for _, v := range res {
+				assert.Contains(t, tc.src, v)
+			}
+		})
+	}
+}
+
+func TestSampleWeighted(t *testing.T) {
+	weightOf := func(weights map[string]float64) func(idx int, src string) float64 {
+		return func(idx int, src string) float64 {
+			return weights[src]
+		}
+	}
+	testCases := []struct {
+		name    string
+		src     []string
+		n       int
+		weights map[string]float64
+		wantErr error
+	}{
+		{
+			name:    "invalid n",
+			src:     []string{"a"},
+			n:       0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:    "empty",
+			n:       1,
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "negative weight",
+			src:     []string{"a", "b"},
+			n:       1,
+			weights: map[string]float64{"a": 1, "b": -1},
+			wantErr: errs.NewErrInvalidArgument("weight", -1.0),
+		},
+		{
+			name:    "inf weight",
+			src:     []string{"a"},
+			n:       1,
+			weights: map[string]float64{"a": math.Inf(1)},
+			wantErr: errs.NewErrInvalidArgument("weight", math.Inf(1)),
+		},
+		{
+			name:    "zero total",
+			src:     []string{"a", "b"},
+			n:       1,
+			weights: map[string]float64{},
+			wantErr: errs.NewErrInvalidArgument("total weight", 0.0),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := SampleWeighted(tc.src, tc.n, weightOf(tc.weights), rand.NewPCG(7, 8))
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+
+	// 权重为 0 的元素不会被抽中，抽中的频率接近权重的比例
+	res, err := SampleWeighted([]string{"a", "b", "c"}, 10000,
+		weightOf(map[string]float64{"a": 1, "c": 3}), rand.NewPCG(7, 8))
+	assert.NoError(t, err)
+	counts := CountBy(res, func(element string) string {
+		return element
+	})
+	assert.Zero(t, counts["b"])
+	assert.InDelta(t, 0.25, float64(counts["a"])/10000, 0.02)
+	assert.InDelta(t, 0.75, float64(counts["c"])/10000, 0.02)
+}
+
+func ExampleSample() {
+	src := []int{1, 2, 3, 4, 5}
+	res, _ := Sample(src, 3, rand.NewPCG(1, 2))
+	fmt.Println(len(res), len(Deduplicate(res)))
+	_, err := Sample(src, 6, nil)
+	fmt.Println(err)
+	// Output:
+	// 3 3
+	// arktools: 元素数量不足，至少需要 6 个, 实际 5 个
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import "github.com/hanleilei/arktools/internal/errs"
+
+// Reduce 从左到右归约切片
+// 以第一个元素作为初始值，依次将 acc 和后续的元素传给 fn，返回最终的 acc
+// idx 是元素在 src 中的下标，因此 fn 收到的第一个 idx 是 1
+// 如果 src 为空或为 nil，返回 ErrEmptySlice
+func Reduce[T any](src []T, fn func(acc T, idx int, src T) T) (T, error) {
+	if len(src) == 0 {
+		var zero T
+		return zero, errs.ErrEmptySlice
+	}
+	acc := src[0]
+	for i := 1; i < len(src); i++ {
+		acc = fn(acc, i, src[i])
+	}
+	return acc, nil
+}
+
+// FoldLeft 从左到右折叠切片
+// 和 Reduce 不同的是，初始值 initial 由使用者提供，并且可以是和元素不同的类型
+// 如果 src 为空或为 nil，返回 initial
+func FoldLeft[Src any, Acc any](src []Src, initial Acc, fn func(acc Acc, idx int, src Src) Acc) Acc {
+	acc := initial
+	for i, s := range src {
+		acc = fn(acc, i, s)
+	}
+	return acc
+}
+
+// FoldRight 从右到左折叠切片
+// idx 依旧是元素在 src 中的下标，因此 fn 收到的第一个 idx 是 len(src)-1
+// 如果 src 为空或为 nil，返回 initial
+func FoldRight[Src any, Acc any](src []Src, initial Acc, fn func(acc Acc, idx int, src Src) Acc) Acc {
+	acc := initial
+	for i := len(src) - 1; i >= 0; i-- {
+		acc = fn(acc, i, src[i])
+	}
+	return acc
+}
+
+// Scan 从左到右折叠切片，并返回每一步的中间结果
+// 返回值的长度和 src 一致，res[i] 是处理完 src[i] 之后的 acc，不包含 initial
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Scan[Src any, Acc any](src []Src, initial Acc, fn func(acc Acc, idx int, src Src) Acc) []Acc {
+	res := make([]Acc, len(src))
+	acc := initial
+	for i, s := range src {
+		acc = fn(acc, i, s)
+		res[i] = acc
+	}
+	return res
+}
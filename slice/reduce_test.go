@@ -0,0 +1,154 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduce(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		want    int
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "empty",
+			src:     []int{},
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name: "value",
+			src:  []int{3},
+			want: 3,
+		},
+		{
+			name: "values",
+			src:  []int{3, 1, 2},
+			want: 6,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Reduce(tc.src, func(acc int, idx int, src int) int {
+				return acc + src
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	// idx 从 1 开始
+	idxes := make([]int, 0, 2)
+	_, _ = Reduce([]string{"a", "b", "c"}, func(acc string, idx int, src string) string {
+		idxes = append(idxes, idx)
+		return acc + src
+	})
+	assert.Equal(t, []int{1, 2}, idxes)
+}
+
+func TestFoldLeftAndFoldRight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		wantLeft  string
+		wantRight string
+	}{
+		{
+			name:      "nil",
+			wantLeft:  ">",
+			wantRight: ">",
+		},
+		{
+			name:      "values",
+			src:       []int{1, 2, 3},
+			wantLeft:  ">0:1,1:2,2:3,",
+			wantRight: ">2:3,1:2,0:1,",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fn := func(acc string, idx int, src int) string {
+				return fmt.Sprintf("%s%d:%d,", acc, idx, src)
+			}
+			assert.Equal(t, tc.wantLeft, FoldLeft(tc.src, ">", fn))
+			assert.Equal(t, tc.wantRight, FoldRight(tc.src, ">", fn))
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "running totals",
+			src:  []int{1, 2, 3, 4},
+			want: []int{11, 13, 16, 20},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Scan(tc.src, 10, func(acc int, idx int, src int) int {
+				return acc + src
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func ExampleFoldLeft() {
+	people := []testutil.Person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}}
+	var sb strings.Builder
+	res := FoldLeft(people, &sb, func(acc *strings.Builder, idx int, p testutil.Person) *strings.Builder {
+		if idx > 0 {
+			acc.WriteString(", ")
+		}
+		acc.WriteString(p.Name + "(" + strconv.Itoa(p.Age) + ")")
+		return acc
+	})
+	fmt.Println(res.String())
+	// Output:
+	// Alice(30), Bob(25)
+}
+
+func ExampleScan() {
+	res := Scan([]int{1, 2, 3, 4}, 0, func(acc int, idx int, src int) int {
+		return acc + src
+	})
+	fmt.Println(res)
+	// Output:
+	// [1 3 6 10]
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplace(t *testing.T) {
+	alice := testutil.Person{Name: "Alice", Age: 30}
+	bob := testutil.Person{Name: "Bob", Age: 25}
+	david := testutil.Person{Name: "David", Age: 40}
+
+	testCases := []struct {
+		name      string
+		slice     []testutil.Person
+		index     int
+		val       testutil.Person
+		wantSlice []testutil.Person
+		wantOld   testutil.Person
+		wantErr   error
+	}{
+		{
+			name:      "replace middle",
+			slice:     []testutil.Person{alice, bob, alice},
+			index:     1,
+			val:       david,
+			wantSlice: []testutil.Person{alice, david, alice},
+			wantOld:   bob,
+		},
+		{
+			name:    "index out of range",
+			slice:   []testutil.Person{alice},
+			index:   1,
+			val:     david,
+			wantErr: errs.NewErrIndexOutOfRange(1, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			old, err := Replace(tc.slice, tc.index, tc.val)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantOld, old)
+			assert.Equal(t, tc.wantSlice, tc.slice)
+		})
+	}
+}
+
+func ExampleReplace() {
+	src := []string{"a", "b", "c"}
+	old, _ := Replace(src, Index(src, "b"), "x")
+	fmt.Println(old, src)
+	// Output:
+	// b [a x c]
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"github.com/hanleilei/arktools/internal/slice"
+)
+
+// Reverse 返回一个元素顺序和 src 相反的新切片，不会修改 src
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Reverse[Src any](src []Src) []Src {
+	res := make([]Src, len(src))
+	for i, s := range src {
+		res[len(src)-1-i] = s
+	}
+	return res
+}
+
+// ReverseSelf 反转 src
+// 所有操作都会在原切片上进行
+func ReverseSelf[Src any](src []Src) {
+	slice.Reverse[Src](src)
+}
+
+// Rotate 返回 src 向左循环移动 k 个位置之后的新切片，不会修改 src
+// 移动之后第一个元素是 src[k]，k 为负数时向右循环移动，k 的绝对值大于 len(src) 时按照 len(src) 取模
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Rotate[Src any](src []Src, k int) []Src {
+	res := make([]Src, 0, len(src))
+	res = append(res, src...)
+	slice.Rotate[Src](res, k)
+	return res
+}
+
+// RotateSelf 将 src 向左循环移动 k 个位置，k 的含义和 Rotate 一致
+// 所有操作都会在原切片上进行，不需要额外的内存
+func RotateSelf[Src any](src []Src, k int) {
+	slice.Rotate[Src](src, k)
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{
+			name: "nil",
+			want: []int{},
+		},
+		{
+			name: "values",
+			src:  []int{1, 2, 3},
+			want: []int{3, 2, 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := append([]int(nil), tc.src...)
+			assert.Equal(t, tc.want, Reverse(tc.src))
+			// 不会修改 src
+			assert.Equal(t, src, tc.src)
+		})
+	}
+}
+
+func TestReverseSelf(t *testing.T) {
+	src := []string{"a", "b", "c", "d"}
+	ReverseSelf(src)
+	assert.Equal(t, []string{"d", "c", "b", "a"}, src)
+}
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		k    int
+		want []int
+	}{
+		{
+			name: "nil",
+			k:    2,
+			want: []int{},
+		},
+		{
+			name: "left",
+			src:  []int{1, 2, 3, 4, 5},
+			k:    2,
+			want: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name: "right",
+			src:  []int{1, 2, 3, 4, 5},
+			k:    -1,
+			want: []int{5, 1, 2, 3, 4},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := append([]int(nil), tc.src...)
+			assert.Equal(t, tc.want, Rotate(tc.src, tc.k))
+			assert.Equal(t, src, tc.src)
+		})
+	}
+}
+
+func TestRotateSelf(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5}
+	RotateSelf(src, 7)
+	assert.Equal(t, []int{3, 4, 5, 1, 2}, src)
+}
+
+func ExampleReverse() {
+	fmt.Println(Reverse([]int{1, 2, 3}))
+	// Output: [3 2 1]
+}
+
+func ExampleRotate() {
+	fmt.Println(Rotate([]int{1, 2, 3, 4, 5}, 2))
+	fmt.Println(Rotate([]int{1, 2, 3, 4, 5}, -2))
+	// Output:
+	// [3 4 5 1 2]
+	// [4 5 1 2 3]
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"github.com/hanleilei/arktools/internal/slice"
+)
+
+// ShrinkPolicy 根据切片的容量 c 和长度 l 计算缩容之后的容量
+// 第二个返回值为 false 表示不需要缩容
+type ShrinkPolicy = slice.ShrinkPolicy
+
+// ShrinkThresholds 是基于阈值的缩容策略的参数，通过 Policy 方法转化为 ShrinkPolicy
+// 容量不超过 MinCap 的切片永远不会缩容；
+// 容量超过 LargeCap 的切片被认为是大切片，容量达到长度的 LargeRatio 倍时，缩容为原容量的 LargeFactor 倍；
+// 其余切片容量达到长度的 SmallRatio 倍时，缩容为原容量的 SmallFactor 倍
+type ShrinkThresholds = slice.ShrinkThresholds
+
+// DefaultShrinkThresholds 返回默认的缩容阈值
+// 可以在默认值的基础上调整部分阈值，例如：
+//
+//	t := DefaultShrinkThresholds()
+//	t.MinCap = 1024
+//	policy := t.Policy()
+func DefaultShrinkThresholds() ShrinkThresholds {
+	return slice.DefaultShrinkThresholds()
+}
+
+// Shrink 使用默认的阈值对切片进行缩容
+// 如果不需要缩容，返回原切片；否则返回一个新的切片
+func Shrink[Src any](src []Src) []Src {
+	return slice.Shrink[Src](src)
+}
+
+// ShrinkFunc 使用 policy 对切片进行缩容
+// 如果 policy 为 nil，使用默认的阈值
+// 如果不需要缩容，返回原切片；否则返回一个新的切片
+func ShrinkFunc[Src any](src []Src, policy ShrinkPolicy) []Src {
+	return slice.ShrinkFunc[Src](src, policy)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShrink(t *testing.T) {
+	s := make([]int, 100, 4096)
+	res := Shrink(s)
+	assert.Equal(t, 2560, cap(res))
+	assert.Equal(t, s, res)
+
+	s = make([]int, 3, 32)
+	res = Shrink(s)
+	assert.Equal(t, 32, cap(res))
+}
+
+func TestShrinkFunc(t *testing.T) {
+	thresholds := DefaultShrinkThresholds()
+	thresholds.MinCap = 1024
+	cases := []struct {
+		name    string
+		len     int
+		capIn   int
+		policy  ShrinkPolicy
+		wantCap int
+	}{
+		{"nil policy", 100, 4096, nil, 2560},
+		{"custom thresholds", 100, 1000, thresholds.Policy(), 1000},
+		{
+			name:  "custom func",
+			len:   10,
+			capIn: 100,
+			policy: func(c, l int) (int, bool) {
+				return l, c > l
+			},
+			wantCap: 10,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := make([]int, c.len, c.capIn)
+			res := ShrinkFunc(s, c.policy)
+			assert.Equal(t, c.wantCap, cap(res))
+			assert.Equal(t, s, res)
+		})
+	}
+}
+
+func ExampleShrinkFunc() {
+	src := make([]int, 3, 100)
+	res := ShrinkFunc(src, func(c, l int) (int, bool) {
+		return l, c > l
+	})
+	fmt.Println(len(res), cap(res))
+	// Output:
+	// 3 3
+}
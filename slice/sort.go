@@ -0,0 +1,108 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/hanleilei/arktools"
+)
+
+// Comparator 比较两个元素
+// 返回值小于 0 表示 a < b，等于 0 表示 a == b，大于 0 表示 a > b
+type Comparator[T any] func(a, b T) int
+
+// CompareBy 返回按照 key 升序比较的 Comparator，key 由使用者从元素中提取
+func CompareBy[T any, K arktools.Ordered](key func(t T) K) Comparator[T] {
+	return func(a, b T) int {
+		return compareOrdered(key(a), key(b))
+	}
+}
+
+// ThenBy 返回一个新的 Comparator，先按照 c 比较，相等的时候再按照 next 比较
+// 可以链式调用来实现多字段排序，例如先按照年龄再按照名字
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if res := c(a, b); res != 0 {
+			return res
+		}
+		return next(a, b)
+	}
+}
+
+// Reverse 返回一个和 c 顺序相反的 Comparator
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// SortBy 按照 key 对 src 原地进行升序排序，key 由使用者从元素中提取
+// 排序是稳定的，key 相同的元素保持原本的相对顺序
+func SortBy[T any, K arktools.Ordered](src []T, key func(t T) K) {
+	SortStableFunc(src, CompareBy(key))
+}
+
+// SortStableFunc 按照 cmp 对 src 原地进行稳定排序
+// cmp 可以通过 CompareBy、ThenBy 和 Reverse 组合出来
+func SortStableFunc[T any](src []T, cmp Comparator[T]) {
+	slices.SortStableFunc(src, cmp)
+}
+
+// IsSorted 判断 src 是否是升序的
+// nil 和空切片被认为是有序的
+func IsSorted[T arktools.Ordered](src []T) bool {
+	return IsSortedFunc(src, compareOrdered[T])
+}
+
+// IsSortedFunc 判断 src 是否按照 cmp 有序
+// nil 和空切片被认为是有序的
+func IsSortedFunc[T any](src []T, cmp Comparator[T]) bool {
+	return slices.IsSortedFunc(src, cmp)
+}
+
+// SearchSorted 在升序的 src 中二分查找 target
+// 返回 target 第一次出现的下标以及是否找到
+// 如果没有找到，返回的下标是 target 应该插入的位置
+// 如果 src 不是升序的，结果是不确定的
+func SearchSorted[T arktools.Ordered](src []T, target T) (int, bool) {
+	return SearchSortedFunc(src, target, compareOrdered[T])
+}
+
+// SearchSortedFunc 在按照 cmp 有序的 src 中二分查找 target
+// 返回值的含义和 SearchSorted 一致
+func SearchSortedFunc[T any](src []T, target T, cmp Comparator[T]) (int, bool) {
+	return slices.BinarySearchFunc(src, target, cmp)
+}
+
+// InsertSorted 将 element 插入到升序的 src 中，插入之后 src 依旧是升序的
+// 存在相等的元素时，element 会插入到它们的后面，保持插入顺序
+// 和 Add 一样，返回的是新的切片，不会修改 src
+func InsertSorted[T arktools.Ordered](src []T, element T) []T {
+	return InsertSortedFunc(src, element, compareOrdered[T])
+}
+
+// InsertSortedFunc 将 element 插入到按照 cmp 有序的 src 中，插入之后 src 依旧有序
+// 存在相等的元素时，element 会插入到它们的后面，保持插入顺序
+// 插入的位置通过二分查找确定，不需要重新排序
+func InsertSortedFunc[T any](src []T, element T, cmp Comparator[T]) []T {
+	idx := sort.Search(len(src), func(i int) bool {
+		return cmp(src[i], element) > 0
+	})
+	// idx 一定在 [0, len(src)] 之间，Add 不会返回错误
+	res, _ := Add(src, element, idx)
+	return res
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortBy(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []testutil.Person
+		want []testutil.Person
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "stable",
+			src: []testutil.Person{
+				{Name: "Tom", Age: 20},
+				{Name: "Jerry", Age: 18},
+				{Name: "Spike", Age: 20},
+				{Name: "Tyke", Age: 1},
+			},
+			want: []testutil.Person{
+				{Name: "Tyke", Age: 1},
+				{Name: "Jerry", Age: 18},
+				{Name: "Tom", Age: 20},
+				{Name: "Spike", Age: 20},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SortBy(tc.src, func(p testutil.Person) int {
+				return p.Age
+			})
+			assert.Equal(t, tc.want, tc.src)
+		})
+	}
+}
+
+func TestSortStableFunc(t *testing.T) {
+	byAge := CompareBy(func(p testutil.Person) int {
+		return p.Age
+	})
+	byName := CompareBy(func(p testutil.Person) string {
+		return p.Name
+	})
+	src := func() []testutil.Person {
+		return []testutil.Person{
+			{Name: "Tom", Age: 20},
+			{Name: "Jerry", Age: 18},
+			{Name: "Spike", Age: 20},
+			{Name: "Butch", Age: 18},
+		}
+	}
+	testCases := []struct {
+		name string
+		cmp  Comparator[testutil.Person]
+		want []testutil.Person
+	}{
+		{
+			name: "then by",
+			cmp:  byAge.ThenBy(byName),
+			want: []testutil.Person{
+				{Name: "Butch", Age: 18},
+				{Name: "Jerry", Age: 18},
+				{Name: "Spike", Age: 20},
+				{Name: "Tom", Age: 20},
+			},
+		},
+		{
+			name: "reverse",
+			cmp:  byAge.Reverse(),
+			want: []testutil.Person{
+				{Name: "Tom", Age: 20},
+				{Name: "Spike", Age: 20},
+				{Name: "Jerry", Age: 18},
+				{Name: "Butch", Age: 18},
+			},
+		},
+		{
+			name: "reverse then by",
+			cmp:  byAge.Reverse().ThenBy(byName),
+			want: []testutil.Person{
+				{Name: "Spike", Age: 20},
+				{Name: "Tom", Age: 20},
+				{Name: "Butch", Age: 18},
+				{Name: "Jerry", Age: 18},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := src()
+			SortStableFunc(res, tc.cmp)
+			assert.Equal(t, tc.want, res)
+			assert.True(t, IsSortedFunc(res, tc.cmp))
+		})
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name: "single",
+			src:  []int{1},
+			want: true,
+		},
+		{
+			name: "sorted with duplicates",
+			src:  []int{1, 2, 2, 3},
+			want: true,
+		},
+		{
+			name: "not sorted",
+			src:  []int{1, 3, 2},
+			want: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsSorted(tc.src))
+		})
+	}
+}
+
+func TestSearchSorted(t *testing.T) {
+	testCases := []struct {
+		name      string
+		src       []int
+		target    int
+		wantIdx   int
+		wantFound bool
+	}{
+		{
+			name:    "nil",
+			target:  1,
+			wantIdx: 0,
+		},
+		{
+			name:      "found first of duplicates",
+			src:       []int{1, 3, 3, 5},
+			target:    3,
+			wantIdx:   1,
+			wantFound: true,
+		},
+		{
+			name:    "not found middle",
+			src:     []int{1, 3, 5},
+			target:  4,
+			wantIdx: 2,
+		},
+		{
+			name:    "not found end",
+			src:     []int{1, 3, 5},
+			target:  6,
+			wantIdx: 3,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idx, found := SearchSorted(tc.src, tc.target)
+			assert.Equal(t, tc.wantIdx, idx)
+			assert.Equal(t, tc.wantFound, found)
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		element int
+		want    []int
+	}{
+		{
+			name:    "nil",
+			element: 1,
+			want:    []int{1},
+		},
+		{
+			name:    "head",
+			src:     []int{2, 3},
+			element: 1,
+			want:    []int{1, 2, 3},
+		},
+		{
+			name:    "middle",
+			src:     []int{1, 3},
+			element: 2,
+			want:    []int{1, 2, 3},
+		},
+		{
+			name:    "tail",
+			src:     []int{1, 2},
+			element: 3,
+			want:    []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := InsertSorted(tc.src, tc.element)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestInsertSortedFunc(t *testing.T) {
+	// 相等的元素插入到已有元素的后面
+	cmp := CompareBy(strings.ToLower)
+	res := InsertSortedFunc([]string{"a", "B", "c"}, "b", cmp)
+	assert.Equal(t, []string{"a", "B", "b", "c"}, res)
+}
+
+func ExampleSortStableFunc() {
+	people := []testutil.Person{
+		{Name: "Tom", Age: 20},
+		{Name: "Jerry", Age: 18},
+		{Name: "Spike", Age: 20},
+	}
+	SortStableFunc(people, CompareBy(func(p testutil.Person) int {
+		return p.Age
+	}).Reverse().ThenBy(CompareBy(func(p testutil.Person) string {
+		return p.Name
+	})))
+	fmt.Println(people)
+	// Output: [{Spike 20} {Tom 20} {Jerry 18}]
+}
+
+func ExampleInsertSorted() {
+	res := InsertSorted([]int{1, 3, 5}, 4)
+	fmt.Println(res)
+	idx, found := SearchSorted(res, 4)
+	fmt.Println(idx, found)
+	// Output:
+	// [1 3 4 5]
+	// 2 true
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"math"
+	"slices"
+
+	"github.com/hanleilei/arktools"
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+// Avg 求平均值。
+// 累加过程使用 float64，因此即便是 int8 之类的小整数类型也不会溢出。
+// 如果 ts 中存在 NaN，结果为 NaN；需要跳过 NaN 的时候可以先用 SumFloat 配合 NaNSkip。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func Avg[T arktools.RealNumber](ts []T) (float64, error) {
+	if len(ts) == 0 {
+		return 0, errs.ErrEmptySlice
+	}
+	var sum float64
+	for _, t := range ts {
+		sum += float64(t)
+	}
+	return sum / float64(len(ts)), nil
+}
+
+// Median 求中位数。
+// 元素个数为偶数的时候，返回中间两个元素的平均值。
+// 不会修改 ts。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice；如果 ts 中存在 NaN，返回 errs.NewErrNaN。
+func Median[T arktools.RealNumber](ts []T) (float64, error) {
+	return Quantile[T](ts, 0.5, InterpolationMidpoint)
+}
+
+// Mode 求众数。
+// 如果有多个元素出现的次数一样多，那么它们都是众数，按照从小到大的顺序返回。
+// NaN 和任何值都不相等，无法统计出现的次数，因此遇到 NaN 时返回 errs.NewErrNaN。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func Mode[T arktools.RealNumber](ts []T) ([]T, error) {
+	if len(ts) == 0 {
+		return nil, errs.ErrEmptySlice
+	}
+	counts := make(map[T]int, len(ts))
+	maxCount := 0
+	for i, t := range ts {
+		if t != t {
+			return nil, errs.NewErrNaN(i)
+		}
+		counts[t]++
+		maxCount = max(maxCount, counts[t])
+	}
+	res := make([]T, 0, len(counts))
+	for t, cnt := range counts {
+		if cnt == maxCount {
+			res = append(res, t)
+		}
+	}
+	slices.Sort(res)
+	return res, nil
+}
+
+// Variance 求总体方差，也就是离差平方和除以 n。
+// 如果 ts 中存在 NaN，结果为 NaN。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func Variance[T arktools.RealNumber](ts []T) (float64, error) {
+	if len(ts) == 0 {
+		return 0, errs.ErrEmptySlice
+	}
+	return sumOfSquaredDeviations[T](ts) / float64(len(ts)), nil
+}
+
+// SampleVariance 求样本方差，也就是离差平方和除以 n-1。
+// 如果 ts 中存在 NaN，结果为 NaN。
+// 至少需要两个元素，否则返回错误。
+func SampleVariance[T arktools.RealNumber](ts []T) (float64, error) {
+	if len(ts) < 2 {
+		return 0, errs.NewErrTooFewElements(2, len(ts))
+	}
+	return sumOfSquaredDeviations[T](ts) / float64(len(ts)-1), nil
+}
+
+// StdDev 求总体标准差。
+// 如果 ts 中存在 NaN，结果为 NaN。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice。
+func StdDev[T arktools.RealNumber](ts []T) (float64, error) {
+	v, err := Variance[T](ts)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(v), nil
+}
+
+// SampleStdDev 求样本标准差。
+// 如果 ts 中存在 NaN，结果为 NaN。
+// 至少需要两个元素，否则返回错误。
+func SampleStdDev[T arktools.RealNumber](ts []T) (float64, error) {
+	v, err := SampleVariance[T](ts)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(v), nil
+}
+
+// sumOfSquaredDeviations 求离差平方和
+// 先求平均值再累加，比一次遍历同时累加 x 和 x^2 的做法精度更高
+func sumOfSquaredDeviations[T arktools.RealNumber](ts []T) float64 {
+	avg, _ := Avg[T](ts)
+	var res float64
+	for _, t := range ts {
+		d := float64(t) - avg
+		res += d * d
+	}
+	return res
+}
+
+// Interpolation 决定了分位点落在两个相邻元素之间时如何取值。
+// lower 和 upper 是排序之后相邻的两个元素，frac 是分位点到 lower 的距离占两者间距的比例，取值范围 [0, 1)。
+// 可以直接使用 InterpolationLinear 等预定义的方法，也可以自定义。
+type Interpolation func(lower, upper, frac float64) float64
+
+// InterpolationLinear 线性插值，这是最常用的方式
+func InterpolationLinear(lower, upper, frac float64) float64 {
+	return lower + (upper-lower)*frac
+}
+
+// InterpolationLower 取较小的元素
+func InterpolationLower(lower, upper, frac float64) float64 {
+	return lower
+}
+
+// InterpolationHigher 取较大的元素，frac 为 0 的时候取 lower
+func InterpolationHigher(lower, upper, frac float64) float64 {
+	if frac == 0 {
+		return lower
+	}
+	return upper
+}
+
+// InterpolationNearest 取距离更近的元素，距离相等的时候取 upper
+func InterpolationNearest(lower, upper, frac float64) float64 {
+	if frac < 0.5 {
+		return lower
+	}
+	return upper
+}
+
+// InterpolationMidpoint 取两个元素的平均值，frac 为 0 的时候取 lower
+func InterpolationMidpoint(lower, upper, frac float64) float64 {
+	if frac == 0 {
+		return lower
+	}
+	return (lower + upper) / 2
+}
+
+// Quantile 求分位数，q 的取值范围是 [0, 1]。
+// 分位点的位置是 (n-1)*q，落在两个元素之间时按照 interpolation 取值；
+// interpolation 为 nil 的时候使用 InterpolationLinear。
+// 不会修改 ts。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice；如果 q 超出范围，返回错误。
+// NaN 无法参与排序，如果 ts 中存在 NaN，返回 errs.NewErrNaN。
+func Quantile[T arktools.RealNumber](ts []T, q float64, interpolation Interpolation) (float64, error) {
+	if len(ts) == 0 {
+		return 0, errs.ErrEmptySlice
+	}
+	// 用 !(q >= 0 && q <= 1) 而不是 q < 0 || q > 1，这样 NaN 也会被拒绝
+	if !(q >= 0 && q <= 1) {
+		return 0, errs.NewErrInvalidQuantile(q)
+	}
+	if interpolation == nil {
+		interpolation = InterpolationLinear
+	}
+	for i, t := range ts {
+		if t != t {
+			return 0, errs.NewErrNaN(i)
+		}
+	}
+	sorted := slices.Clone(ts)
+	slices.Sort(sorted)
+	pos := float64(len(sorted)-1) * q
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return interpolation(float64(sorted[lo]), float64(sorted[hi]), pos-float64(lo)), nil
+}
+
+// Percentile 求百分位数，p 的取值范围是 [0, 100]。
+// 等价于 Quantile(ts, p/100, interpolation)。
+func Percentile[T arktools.RealNumber](ts []T, p float64, interpolation Interpolation) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, errs.NewErrInvalidPercentile(p)
+	}
+	return Quantile[T](ts, p/100, interpolation)
+}
+
+// HistogramBucket 直方图中的一个桶，统计落在 [Low, High) 之间的元素个数。
+// 最后一个桶是闭区间 [Low, High]，这样最大值也会被统计进去。
+type HistogramBucket struct {
+	Low   float64
+	High  float64
+	Count int
+}
+
+// Histogram 将 ts 按照等宽的方式分成 bins 个桶，统计每个桶中元素的个数。
+// 桶的范围从 ts 的最小值开始，到最大值结束。
+// 如果所有元素都相等，那么所有元素都落在第一个桶中。
+// 如果 ts 为空或为 nil，返回 ErrEmptySlice；如果 bins <= 0，返回错误。
+// 如果 ts 中存在 NaN，返回 errs.NewErrNaN；存在正负无穷大时无法划分桶的范围，返回 errs.NewErrInvalidArgument。
+func Histogram[T arktools.RealNumber](ts []T, bins int) ([]HistogramBucket, error) {
+	if bins <= 0 {
+		return nil, errs.NewErrInvalidSize(bins)
+	}
+	if len(ts) == 0 {
+		return nil, errs.ErrEmptySlice
+	}
+	for i, t := range ts {
+		v := float64(t)
+		if math.IsNaN(v) {
+			return nil, errs.NewErrNaN(i)
+		}
+		if math.IsInf(v, 0) {
+			return nil, errs.NewErrInvalidArgument("ts", v)
+		}
+	}
+	low, high := float64(slices.Min(ts)), float64(slices.Max(ts))
+	// 使用一半的宽度计算，即使 high - low 超出了 float64 的范围也不会溢出
+	halfSpan := high/2 - low/2
+	halfWidth := halfSpan / float64(bins)
+	res := make([]HistogramBucket, bins)
+	for i := range res {
+		res[i].Low = low + halfWidth*float64(i) + halfWidth*float64(i)
+		res[i].High = low + halfWidth*float64(i+1) + halfWidth*float64(i+1)
+	}
+	res[bins-1].High = high
+	for _, t := range ts {
+		idx := 0
+		if halfSpan > 0 {
+			pos := (float64(t)/2 - low/2) / halfSpan * float64(bins)
+			idx = int(max(min(pos, float64(bins-1)), 0))
+		}
+		res[idx].Count++
+	}
+	return res, nil
+}
@@ -0,0 +1,443 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvg(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []int8
+		want    float64
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:  "values",
+			input: []int8{1, 2, 3, 4},
+			want:  2.5,
+		},
+		{
+			name:  "no overflow",
+			input: []int8{127, 127, 127},
+			want:  127,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Avg(tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	// NaN 会传播到结果中
+	res, err := Avg([]float64{1, math.NaN(), 3})
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(res))
+}
+
+func TestMedian(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []int
+		want    float64
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:  "value",
+			input: []int{3},
+			want:  3,
+		},
+		{
+			name:  "odd",
+			input: []int{3, 1, 2},
+			want:  2,
+		},
+		{
+			name:  "even",
+			input: []int{4, 1, 3, 2},
+			want:  2.5,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := append([]int(nil), tc.input...)
+			res, err := Median(tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+			// 不会修改原切片
+			assert.Equal(t, input, tc.input)
+		})
+	}
+
+	// NaN 无法参与排序
+	_, err := Median([]float64{math.NaN(), 1, 2})
+	assert.Equal(t, errs.NewErrNaN(0), err)
+}
+
+func TestMode(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []int
+		want    []int
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:  "single mode",
+			input: []int{1, 2, 2, 3},
+			want:  []int{2},
+		},
+		{
+			name:  "multiple modes",
+			input: []int{3, 1, 3, 1, 2},
+			want:  []int{1, 3},
+		},
+		{
+			name:  "all distinct",
+			input: []int{3, 1, 2},
+			want:  []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Mode(tc.input)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	// NaN 无法统计次数
+	_, err := Mode([]float64{1, math.NaN(), math.NaN(), 1})
+	assert.Equal(t, errs.NewErrNaN(1), err)
+	res, err := Mode([]float64{1.5, 2.5, 1.5})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5}, res)
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         []float64
+		wantVar       float64
+		wantVarErr    error
+		wantSample    float64
+		wantSampleErr error
+	}{
+		{
+			name:          "nil",
+			wantVarErr:    ErrEmptySlice,
+			wantSampleErr: errs.NewErrTooFewElements(2, 0),
+		},
+		{
+			name:          "value",
+			input:         []float64{3},
+			wantVar:       0,
+			wantSampleErr: errs.NewErrTooFewElements(2, 1),
+		},
+		{
+			name:       "values",
+			input:      []float64{2, 4, 4, 4, 5, 5, 7, 9},
+			wantVar:    4,
+			wantSample: 32.0 / 7,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Variance(tc.input)
+			assert.Equal(t, tc.wantVarErr, err)
+			assert.InDelta(t, tc.wantVar, res, 1e-9)
+			res, err = StdDev(tc.input)
+			assert.Equal(t, tc.wantVarErr, err)
+			assert.InDelta(t, math.Sqrt(tc.wantVar), res, 1e-9)
+
+			res, err = SampleVariance(tc.input)
+			assert.Equal(t, tc.wantSampleErr, err)
+			assert.InDelta(t, tc.wantSample, res, 1e-9)
+			res, err = SampleStdDev(tc.input)
+			assert.Equal(t, tc.wantSampleErr, err)
+			assert.InDelta(t, math.Sqrt(tc.wantSample), res, 1e-9)
+		})
+	}
+
+	// NaN 会传播到结果中
+	nanInput := []float64{1, math.NaN(), 3}
+	for _, fn := range []func([]float64) (float64, error){Variance[float64], StdDev[float64], SampleVariance[float64], SampleStdDev[float64]} {
+		res, err := fn(nanInput)
+		assert.NoError(t, err)
+		assert.True(t, math.IsNaN(res))
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	input := []int{4, 1, 3, 2, 5}
+	testCases := []struct {
+		name          string
+		input         []int
+		q             float64
+		interpolation Interpolation
+		want          float64
+		wantErr       error
+	}{
+		{
+			name:    "nil",
+			q:       0.5,
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "q less than 0",
+			input:   input,
+			q:       -0.1,
+			wantErr: errs.NewErrInvalidQuantile(-0.1),
+		},
+		{
+			name:    "q greater than 1",
+			input:   input,
+			q:       1.1,
+			wantErr: errs.NewErrInvalidQuantile(1.1),
+		},
+		{
+			name:  "min",
+			input: input,
+			q:     0,
+			want:  1,
+		},
+		{
+			name:  "max",
+			input: input,
+			q:     1,
+			want:  5,
+		},
+		{
+			name:  "nil interpolation is linear",
+			input: input,
+			q:     0.3,
+			want:  2.2,
+		},
+		{
+			name:          "linear",
+			input:         input,
+			q:             0.3,
+			interpolation: InterpolationLinear,
+			want:          2.2,
+		},
+		{
+			name:          "lower",
+			input:         input,
+			q:             0.3,
+			interpolation: InterpolationLower,
+			want:          2,
+		},
+		{
+			name:          "higher",
+			input:         input,
+			q:             0.3,
+			interpolation: InterpolationHigher,
+			want:          3,
+		},
+		{
+			name:          "higher exact",
+			input:         input,
+			q:             0.25,
+			interpolation: InterpolationHigher,
+			want:          2,
+		},
+		{
+			name:          "nearest",
+			input:         input,
+			q:             0.3,
+			interpolation: InterpolationNearest,
+			want:          2,
+		},
+		{
+			name:          "nearest upper",
+			input:         input,
+			q:             0.4,
+			interpolation: InterpolationNearest,
+			want:          3,
+		},
+		{
+			name:          "midpoint",
+			input:         input,
+			q:             0.3,
+			interpolation: InterpolationMidpoint,
+			want:          2.5,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Quantile(tc.input, tc.q, tc.interpolation)
+			assert.Equal(t, tc.wantErr, err)
+			assert.InDelta(t, tc.want, res, 1e-9)
+		})
+	}
+
+	_, err := Quantile(input, math.NaN(), nil)
+	assert.Error(t, err)
+	_, err = Quantile([]float64{1, 2, math.NaN()}, 0.9, nil)
+	assert.Equal(t, errs.NewErrNaN(2), err)
+}
+
+func TestPercentile(t *testing.T) {
+	input := []float64{15, 20, 35, 40, 50}
+	res, err := Percentile(input, 40, InterpolationLinear)
+	assert.NoError(t, err)
+	assert.InDelta(t, 29, res, 1e-9)
+
+	_, err = Percentile(input, 101, nil)
+	assert.Equal(t, errs.NewErrInvalidPercentile(101), err)
+	_, err = Percentile([]float64{1, math.NaN()}, 50, nil)
+	assert.Equal(t, errs.NewErrNaN(1), err)
+	_, err = Percentile([]float64{}, 50, nil)
+	assert.Equal(t, ErrEmptySlice, err)
+}
+
+func TestHistogram(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []int
+		bins    int
+		want    []HistogramBucket
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			bins:    2,
+			wantErr: ErrEmptySlice,
+		},
+		{
+			name:    "invalid bins",
+			input:   []int{1, 2},
+			bins:    0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name:  "values",
+			input: []int{1, 2, 2, 3, 5, 9},
+			bins:  4,
+			want: []HistogramBucket{
+				{Low: 1, High: 3, Count: 3},
+				{Low: 3, High: 5, Count: 1},
+				{Low: 5, High: 7, Count: 1},
+				{Low: 7, High: 9, Count: 1},
+			},
+		},
+		{
+			name:  "all equal",
+			input: []int{2, 2, 2},
+			bins:  2,
+			want: []HistogramBucket{
+				{Low: 2, High: 2, Count: 3},
+				{Low: 2, High: 2, Count: 0},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Histogram(tc.input, tc.bins)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestHistogram_Float(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   []float64
+		bins    int
+		want    []HistogramBucket
+		wantErr error
+	}{
+		{
+			name:    "NaN",
+			input:   []float64{1, math.NaN(), 3},
+			bins:    2,
+			wantErr: errs.NewErrNaN(1),
+		},
+		{
+			name:    "positive infinity",
+			input:   []float64{1, math.Inf(1)},
+			bins:    2,
+			wantErr: errs.NewErrInvalidArgument("ts", math.Inf(1)),
+		},
+		{
+			name:    "negative infinity",
+			input:   []float64{math.Inf(-1), 1},
+			bins:    2,
+			wantErr: errs.NewErrInvalidArgument("ts", math.Inf(-1)),
+		},
+		{
+			name:  "span overflows float64",
+			input: []float64{-math.MaxFloat64, 0, math.MaxFloat64},
+			bins:  2,
+			want: []HistogramBucket{
+				{Low: -math.MaxFloat64, High: 0, Count: 1},
+				{Low: 0, High: math.MaxFloat64, Count: 2},
+			},
+		},
+		{
+			name:  "values",
+			input: []float64{0, 0.5, 1, 1.5, 2},
+			bins:  2,
+			want: []HistogramBucket{
+				{Low: 0, High: 1, Count: 2},
+				{Low: 1, High: 2, Count: 3},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Histogram(tc.input, tc.bins)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func ExampleMedian() {
+	res, _ := Median([]int{4, 1, 3, 2})
+	fmt.Println(res)
+	// Output:
+	// 2.5
+}
+
+func ExamplePercentile() {
+	res, _ := Percentile([]int{1, 2, 3, 4, 5}, 90, InterpolationLinear)
+	fmt.Println(res)
+	_, err := Percentile([]int{}, 90, InterpolationLinear)
+	fmt.Println(err == ErrEmptySlice)
+	// Output:
+	// 4.6
+	// true
+}
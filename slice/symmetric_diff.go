@@ -0,0 +1,38 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+// SymmetricDiffSet 对称差集，只支持 comparable
+// 返回只在 src 或者只在 dst 中出现的元素
+// 已去重
+// 返回值的元素顺序是确定的：先是只在 src 中的元素，然后是只在 dst 中的元素，
+// 各自保持第一次出现的顺序
+func SymmetricDiffSet[T comparable](src, dst []T) []T {
+	var ret = make([]T, 0, len(src)+len(dst))
+	ret = append(ret, DiffSet[T](src, dst)...)
+	ret = append(ret, DiffSet[T](dst, src)...)
+	return ret
+}
+
+// SymmetricDiffSetFunc 对称差集，支持任意类型
+// 你应该优先使用 SymmetricDiffSet
+// 已去重
+// 返回值的元素顺序和 SymmetricDiffSet 一致
+func SymmetricDiffSetFunc[T any](src, dst []T, equal equalFunc[T]) []T {
+	var ret = make([]T, 0, len(src)+len(dst))
+	ret = append(ret, DiffSetFunc[T](src, dst, equal)...)
+	ret = append(ret, DiffSetFunc[T](dst, src, equal)...)
+	return ret
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymmetricDiffSet(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		dst  []int
+		want []int
+	}{
+		{
+			src:  []int{1, 2, 3, 4},
+			dst:  []int{4, 5, 6, 1},
+			want: []int{2, 3, 5, 6},
+			name: "not empty",
+		},
+		{
+			src:  []int{3, 1, 3, 2},
+			dst:  []int{7, 1, 7, 8},
+			want: []int{3, 2, 7, 8},
+			name: "keep order and deduplicate",
+		},
+		{
+			src:  []int{1, 2},
+			dst:  []int{2, 1},
+			want: []int{},
+			name: "equal sets",
+		},
+		{
+			src:  []int{},
+			dst:  []int{1, 3},
+			want: []int{1, 3},
+			name: "src is empty",
+		},
+		{
+			src:  []int{1, 3},
+			dst:  []int{},
+			want: []int{1, 3},
+			name: "dst is empty",
+		},
+		{
+			src:  []int{},
+			dst:  []int{},
+			want: []int{},
+			name: "src and dst are empty",
+		},
+		{
+			src:  nil,
+			dst:  nil,
+			want: []int{},
+			name: "src and dst are nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := SymmetricDiffSet[int](tt.src, tt.dst)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestSymmetricDiffSetFunc(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		dst  []int
+		want []int
+	}{
+		{
+			src:  []int{1, 2, 3, 4},
+			dst:  []int{4, 5, 6, 1},
+			want: []int{2, 3, 5, 6},
+			name: "not empty",
+		},
+		{
+			src:  []int{3, 1, 3, 2},
+			dst:  []int{7, 1, 7, 8},
+			want: []int{3, 2, 7, 8},
+			name: "keep order and deduplicate",
+		},
+		{
+			src:  []int{1, 2},
+			dst:  []int{2, 1},
+			want: []int{},
+			name: "equal sets",
+		},
+		{
+			src:  []int{},
+			dst:  []int{1, 3},
+			want: []int{1, 3},
+			name: "src is empty",
+		},
+		{
+			src:  []int{1, 3},
+			dst:  []int{},
+			want: []int{1, 3},
+			name: "dst is empty",
+		},
+		{
+			src:  []int{},
+			dst:  []int{},
+			want: []int{},
+			name: "src and dst are empty",
+		},
+		{
+			src:  nil,
+			dst:  nil,
+			want: []int{},
+			name: "src and dst are nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := SymmetricDiffSetFunc[int](tt.src, tt.dst, func(src, dst int) bool {
+				return src == dst
+			})
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func ExampleSymmetricDiffSet() {
+	res := SymmetricDiffSet[int]([]int{1, 3, 4, 5}, []int{1, 4, 7})
+	fmt.Println(res)
+	// Output:
+	// [3 5 7]
+}
+
+func ExampleSymmetricDiffSetFunc() {
+	res := SymmetricDiffSetFunc[int]([]int{1, 3, 4, 5}, []int{1, 4, 7}, func(src, dst int) bool {
+		return src == dst
+	})
+	fmt.Println(res)
+	// Output:
+	// [3 5 7]
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/hanleilei/arktools"
+	"github.com/hanleilei/arktools/internal/errs"
+)
+
+// TopK 返回 src 中最大的 k 个元素，结果按照降序排列
+// 使用容量为 k 的堆实现，时间复杂度是 O(n log k)，不会修改 src
+// 如果 k 大于 len(src)，返回所有元素
+// 如果 k 小于等于 0，返回 errs.NewErrInvalidSize
+func TopK[T arktools.Ordered](src []T, k int) ([]T, error) {
+	return TopKFunc(src, k, compareOrdered[T])
+}
+
+// TopKFunc 按照 cmp 返回 src 中最大的 k 个元素，结果按照降序排列
+// cmp 可以通过 CompareBy、ThenBy 和 Reverse 组合出来
+// 相等的元素之间的先后顺序是不确定的
+func TopKFunc[T any](src []T, k int, cmp Comparator[T]) ([]T, error) {
+	if k <= 0 {
+		return nil, errs.NewErrInvalidSize(k)
+	}
+	// 小顶堆，堆顶是目前为止第 k 大的元素
+	h := boundedHeap[T]{data: make([]T, 0, min(k, len(src))), cmp: cmp}
+	for _, s := range src {
+		if len(h.data) < k {
+			h.push(s)
+		} else if cmp(h.data[0], s) < 0 {
+			h.replaceTop(s)
+		}
+	}
+	res := h.data
+	slices.SortFunc(res, cmp.Reverse())
+	return res, nil
+}
+
+// BottomK 返回 src 中最小的 k 个元素，结果按照升序排列
+// 其余的语义和 TopK 一致
+func BottomK[T arktools.Ordered](src []T, k int) ([]T, error) {
+	return BottomKFunc(src, k, compareOrdered[T])
+}
+
+// BottomKFunc 按照 cmp 返回 src 中最小的 k 个元素，结果按照升序排列
+// 其余的语义和 TopKFunc 一致
+func BottomKFunc[T any](src []T, k int, cmp Comparator[T]) ([]T, error) {
+	return TopKFunc(src, k, cmp.Reverse())
+}
+
+// NthElement 原地重排 src，使得 src[n] 是升序排序之后位于下标 n 的元素，并返回该元素
+// 重排之后 src[:n] 中的元素都不大于 src[n]，src[n+1:] 中的元素都不小于 src[n]，但两部分内部是无序的
+// 使用随机选择枢轴的快速选择算法，期望时间复杂度是 O(n)
+// 如果 n 超出范围（< 0 或 >= len(src)），返回 errs.NewErrIndexOutOfRange
+func NthElement[T arktools.Ordered](src []T, n int) (T, error) {
+	return NthElementFunc(src, n, compareOrdered[T])
+}
+
+// NthElementFunc 按照 cmp 原地重排 src，使得 src[n] 是排序之后位于下标 n 的元素，并返回该元素
+// 其余的语义和 NthElement 一致
+func NthElementFunc[T any](src []T, n int, cmp Comparator[T]) (T, error) {
+	if n < 0 || n >= len(src) {
+		var zero T
+		return zero, errs.NewErrIndexOutOfRange(len(src), n)
+	}
+	quickselect(src, n, cmp)
+	return src[n], nil
+}
+
+// Select 返回 src 升序排序之后位于下标 n 的元素，例如 n 为 0 时返回最小值
+// 和 NthElement 不同的是，Select 不会修改 src，代价是复制一次 src
+// 如果 n 超出范围（< 0 或 >= len(src)），返回 errs.NewErrIndexOutOfRange
+func Select[T arktools.Ordered](src []T, n int) (T, error) {
+	return SelectFunc(src, n, compareOrdered[T])
+}
+
+// SelectFunc 按照 cmp 返回 src 排序之后位于下标 n 的元素，不会修改 src
+// 其余的语义和 Select 一致
+func SelectFunc[T any](src []T, n int, cmp Comparator[T]) (T, error) {
+	if n < 0 || n >= len(src) {
+		var zero T
+		return zero, errs.NewErrIndexOutOfRange(len(src), n)
+	}
+	return NthElementFunc(slices.Clone(src), n, cmp)
+}
+
+// quickselect 在 [lo, hi) 中不断三路划分，直到 n 落在等于枢轴的区间内
+// 三路划分保证大量重复元素时依旧是线性的
+func quickselect[T any](src []T, n int, cmp Comparator[T]) {
+	lo, hi := 0, len(src)
+	for hi-lo > 1 {
+		pivot := src[lo+rand.IntN(hi-lo)]
+		// [lo, lt) < pivot，[lt, i) == pivot，[gt, hi) > pivot
+		lt, i, gt := lo, lo, hi
+		for i < gt {
+			switch c := cmp(src[i], pivot); {
+			case c < 0:
+				src[lt], src[i] = src[i], src[lt]
+				lt++
+				i++
+			case c > 0:
+				gt--
+				src[gt], src[i] = src[i], src[gt]
+			default:
+				i++
+			}
+		}
+		switch {
+		case n < lt:
+			hi = lt
+		case n >= gt:
+			lo = gt
+		default:
+			return
+		}
+	}
+}
+
+// boundedHeap 是按照 cmp 组织的小顶堆，TopK 用它保留最大的 k 个元素
+type boundedHeap[T any] struct {
+	data []T
+	cmp  Comparator[T]
+}
+
+func (h *boundedHeap[T]) push(t T) {
+	h.data = append(h.data, t)
+	// 上浮
+	i := len(h.data) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if h.cmp(h.data[i], h.data[parent]) >= 0 {
+			break
+		}
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+// replaceTop 用 t 替换堆顶，然后下沉
+func (h *boundedHeap[T]) replaceTop(t T) {
+	h.data[0] = t
+	i, n := 0, len(h.data)
+	for {
+		smallest := i
+		if l := 2*i + 1; l < n && h.cmp(h.data[l], h.data[smallest]) < 0 {
+			smallest = l
+		}
+		if r := 2*i + 2; r < n && h.cmp(h.data[r], h.data[smallest]) < 0 {
+			smallest = r
+		}
+		if smallest == i {
+			return
+		}
+		h.data[i], h.data[smallest] = h.data[smallest], h.data[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopK(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		k       int
+		want    []int
+		wantErr error
+	}{
+		{
+			name:    "invalid k",
+			src:     []int{1, 2},
+			k:       0,
+			wantErr: errs.NewErrInvalidSize(0),
+		},
+		{
+			name: "nil",
+			k:    3,
+			want: []int{},
+		},
+		{
+			name: "k larger than length",
+			src:  []int{2, 3, 1},
+			k:    5,
+			want: []int{3, 2, 1},
+		},
+		{
+			name: "top k",
+			src:  []int{5, 1, 9, 3, 7, 9, 2},
+			k:    3,
+			want: []int{9, 9, 7},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := slices.Clone(tc.src)
+			res, err := TopK(tc.src, tc.k)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+			// 不会修改 src
+			assert.Equal(t, src, tc.src)
+		})
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []float64
+		k       int
+		want    []float64
+		wantErr error
+	}{
+		{
+			name:    "invalid k",
+			k:       -1,
+			wantErr: errs.NewErrInvalidSize(-1),
+		},
+		{
+			name: "bottom k",
+			src:  []float64{5, 1.5, 9, 3, 7, 1.5, 2},
+			k:    3,
+			want: []float64{1.5, 1.5, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := BottomK(tc.src, tc.k)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestTopKFunc(t *testing.T) {
+	people := []testutil.Person{
+		{Name: "Tom", Age: 20},
+		{Name: "Jerry", Age: 18},
+		{Name: "Spike", Age: 30},
+		{Name: "Tyke", Age: 1},
+	}
+	byAge := CompareBy(func(p testutil.Person) int {
+		return p.Age
+	})
+	res, err := TopKFunc(people, 2, byAge)
+	assert.NoError(t, err)
+	assert.Equal(t, []testutil.Person{{Name: "Spike", Age: 30}, {Name: "Tom", Age: 20}}, res)
+
+	res, err = BottomKFunc(people, 2, byAge)
+	assert.NoError(t, err)
+	assert.Equal(t, []testutil.Person{{Name: "Tyke", Age: 1}, {Name: "Jerry", Age: 18}}, res)
+
+	// 组合出来的 Comparator 可以直接使用
+	byName := CompareBy(func(p testutil.Person) string {
+		return p.Name
+	})
+	res, err = TopKFunc(append(people, testutil.Person{Name: "Butch", Age: 30}), 2, byAge.ThenBy(byName.Reverse()))
+	assert.NoError(t, err)
+	assert.Equal(t, []testutil.Person{{Name: "Butch", Age: 30}, {Name: "Spike", Age: 30}}, res)
+}
+
+func TestTopK_Random(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		src := make([]int, 1000)
+		for j := range src {
+			src[j] = rand.IntN(100)
+		}
+		k := rand.IntN(50) + 1
+		sorted := slices.Clone(src)
+		slices.Sort(sorted)
+
+		res, err := BottomK(src, k)
+		assert.NoError(t, err)
+		assert.Equal(t, sorted[:k], res)
+
+		res, err = TopK(src, k)
+		assert.NoError(t, err)
+		slices.Reverse(res)
+		assert.Equal(t, sorted[len(sorted)-k:], res)
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		n       int
+		want    int
+		wantErr error
+	}{
+		{
+			name:    "nil",
+			n:       0,
+			wantErr: errs.NewErrIndexOutOfRange(0, 0),
+		},
+		{
+			name:    "negative",
+			src:     []int{1, 2},
+			n:       -1,
+			wantErr: errs.NewErrIndexOutOfRange(2, -1),
+		},
+		{
+			name:    "out of range",
+			src:     []int{1, 2},
+			n:       2,
+			wantErr: errs.NewErrIndexOutOfRange(2, 2),
+		},
+		{
+			name: "single",
+			src:  []int{1},
+			n:    0,
+			want: 1,
+		},
+		{
+			name: "median",
+			src:  []int{5, 1, 4, 2, 3},
+			n:    2,
+			want: 3,
+		},
+		{
+			name: "duplicates",
+			src:  []int{2, 2, 2, 1, 2, 3, 2},
+			n:    5,
+			want: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NthElement(tc.src, tc.n)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.want, tc.src[tc.n])
+			for _, v := range tc.src[:tc.n] {
+				assert.LessOrEqual(t, v, tc.want)
+			}
+			for _, v := range tc.src[tc.n+1:] {
+				assert.GreaterOrEqual(t, v, tc.want)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		src := make([]int, 500)
+		for j := range src {
+			src[j] = rand.IntN(50)
+		}
+		origin := slices.Clone(src)
+		sorted := slices.Clone(src)
+		slices.Sort(sorted)
+		n := rand.IntN(len(src))
+
+		res, err := Select(src, n)
+		assert.NoError(t, err)
+		assert.Equal(t, sorted[n], res)
+		// 不会修改 src
+		assert.Equal(t, origin, src)
+	}
+
+	_, err := Select([]int{}, 0)
+	assert.Equal(t, errs.NewErrIndexOutOfRange(0, 0), err)
+}
+
+func TestSelectFunc(t *testing.T) {
+	people := []testutil.Person{
+		{Name: "Tom", Age: 20},
+		{Name: "Jerry", Age: 18},
+		{Name: "Spike", Age: 30},
+	}
+	res, err := SelectFunc(people, 1, CompareBy(func(p testutil.Person) int {
+		return p.Age
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, testutil.Person{Name: "Tom", Age: 20}, res)
+}
+
+func ExampleTopK() {
+	scores := []int{87, 95, 62, 100, 78, 95}
+	res, _ := TopK(scores, 3)
+	fmt.Println(res)
+	// Output: [100 95 95]
+}
+
+func ExampleSelect() {
+	res, _ := Select([]int{7, 1, 5, 3, 9}, 2)
+	fmt.Println(res)
+	// Output: 5
+}
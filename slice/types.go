@@ -14,7 +14,12 @@
 
 package slice
 
+import "github.com/hanleilei/arktools/internal/errs"
+
 // equalFunc 比较两个元素是否相等
 type equalFunc[T any] func(src, dst T) bool
 
 type matchFunc[T any] func(src T) bool
+
+// ErrEmptySlice 代表传入的切片为空，可以用 errors.Is 判断
+var ErrEmptySlice = errs.ErrEmptySlice
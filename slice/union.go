@@ -16,28 +16,24 @@ package slice
 
 // UnionSet 并集，只支持 comparable
 // 已去重
-// 返回值的元素顺序是不定的
+// 返回值的元素顺序是确定的：先是 src 中的元素，然后是 dst 中的元素，
+// 重复的元素只保留第一次出现的位置
 func UnionSet[T comparable](src, dst []T) []T {
-	srcMap, dstMap := toMap[T](src), toMap[T](dst)
-	for key := range srcMap {
-		dstMap[key] = struct{}{}
-	}
-
-	var ret = make([]T, 0, len(dstMap))
-	for key := range dstMap {
-		ret = append(ret, key)
-	}
+	var ret = make([]T, 0, len(src)+len(dst))
+	ret = append(ret, src...)
+	ret = append(ret, dst...)
 
-	return ret
+	return Deduplicate[T](ret)
 }
 
 // UnionSetFunc 并集，支持任意类型
 // 你应该优先使用 UnionSet
 // 已去重
+// 返回值的元素顺序和 UnionSet 一致
 func UnionSetFunc[T any](src, dst []T, equal equalFunc[T]) []T {
 	var ret = make([]T, 0, len(src)+len(dst))
-	ret = append(ret, dst...)
 	ret = append(ret, src...)
+	ret = append(ret, dst...)
 
-	return deduplicateFunc[T](ret, equal)
+	return DeduplicateFunc[T](ret, equal)
 }
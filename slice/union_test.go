@@ -16,7 +16,6 @@ package slice
 
 import (
 	"fmt"
-	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -54,11 +53,23 @@ func TestUnionSet(t *testing.T) {
 			want: []int{},
 			name: "src and dst are empty",
 		},
+		{
+			src:  []int{3, 1, 3},
+			dst:  []int{2, 1, 4, 2},
+			want: []int{3, 1, 2, 4},
+			name: "keep first occurrence order",
+		},
+		{
+			src:  nil,
+			dst:  nil,
+			want: []int{},
+			name: "src and dst are nil",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			res := UnionSet[int](tt.src, tt.dst)
-			assert.ElementsMatch(t, tt.want, res)
+			assert.Equal(t, tt.want, res)
 		})
 	}
 }
@@ -95,20 +106,31 @@ func TestUnionSetFunc(t *testing.T) {
 			want: []int{},
 			name: "src and dst are empty",
 		},
+		{
+			src:  []int{3, 1, 3},
+			dst:  []int{2, 1, 4, 2},
+			want: []int{3, 1, 2, 4},
+			name: "keep first occurrence order",
+		},
+		{
+			src:  nil,
+			dst:  nil,
+			want: []int{},
+			name: "src and dst are nil",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			res := UnionSetFunc[int](tt.src, tt.dst, func(src, dst int) bool {
 				return src == dst
 			})
-			assert.ElementsMatch(t, tt.want, res)
+			assert.Equal(t, tt.want, res)
 		})
 	}
 }
 
 func ExampleUnionSet() {
 	res := UnionSet[int]([]int{1, 3, 4, 5}, []int{1, 4, 7})
-	sort.Ints(res)
 	fmt.Println(res)
 	// Output:
 	// [1 3 4 5 7]
@@ -118,7 +140,6 @@ func ExampleUnionSetFunc() {
 	res := UnionSetFunc[int]([]int{1, 3, 4, 5}, []int{1, 4, 7}, func(src, dst int) bool {
 		return src == dst
 	})
-	sort.Ints(res)
 	fmt.Println(res)
 	// Output:
 	// [1 3 4 5 7]
@@ -0,0 +1,78 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/hanleilei/arktools/tuple"
+)
+
+// ZipPolicy 决定 Zip 在两个切片长度不同时如何处理
+type ZipPolicy int
+
+const (
+	// ZipTruncate 以较短的切片为准，较长切片多余的元素会被忽略
+	ZipTruncate ZipPolicy = iota
+	// ZipError 长度不同时返回错误，错误中包含两个切片的长度
+	ZipError
+	// ZipPad 以较长的切片为准，较短的切片缺少的元素使用零值填充
+	ZipPad
+)
+
+// Zip 将 a 和 b 按位置一一配对，长度不同时按照 policy 处理
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func Zip[A any, B any](a []A, b []B, policy ZipPolicy) ([]tuple.Pair[A, B], error) {
+	return ZipWith(a, b, policy, func(idx int, a A, b B) tuple.Pair[A, B] {
+		return tuple.NewPair(a, b)
+	})
+}
+
+// ZipWith 将 a 和 b 按位置一一配对，并使用 fn 转化为 Dst，长度不同时按照 policy 处理
+// 在 ZipPad 下，fn 收到的缺失的元素是零值
+// 即使传入的切片为 nil，也保证返回的是一个空切片而不是 nil
+func ZipWith[A any, B any, Dst any](a []A, b []B, policy ZipPolicy, fn func(idx int, a A, b B) Dst) ([]Dst, error) {
+	length := min(len(a), len(b))
+	if len(a) != len(b) {
+		switch policy {
+		case ZipError:
+			return nil, errs.NewErrLengthMismatch(len(a), len(b))
+		case ZipPad:
+			length = max(len(a), len(b))
+		}
+	}
+	res := make([]Dst, length)
+	for i := range res {
+		var va A
+		var vb B
+		if i < len(a) {
+			va = a[i]
+		}
+		if i < len(b) {
+			vb = b[i]
+		}
+		res[i] = fn(i, va, vb)
+	}
+	return res, nil
+}
+
+// Unzip 是 Zip 的逆操作，将二元组切片拆分为 Key 的切片和 Value 的切片
+// 即使传入的切片为 nil，也保证返回的是空切片而不是 nil
+func Unzip[A any, B any](pairs []tuple.Pair[A, B]) ([]A, []B) {
+	as, bs := make([]A, len(pairs)), make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.Split()
+	}
+	return as, bs
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hanleilei/arktools/internal/errs"
+	"github.com/hanleilei/arktools/testutil"
+	"github.com/hanleilei/arktools/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a       []int
+		b       []string
+		policy  ZipPolicy
+		want    []tuple.Pair[int, string]
+		wantErr error
+	}{
+		{
+			name:   "nil",
+			policy: ZipTruncate,
+			want:   []tuple.Pair[int, string]{},
+		},
+		{
+			name:   "same length",
+			a:      []int{1, 2},
+			b:      []string{"a", "b"},
+			policy: ZipError,
+			want:   []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "b")},
+		},
+		{
+			name:   "truncate",
+			a:      []int{1, 2, 3},
+			b:      []string{"a", "b"},
+			policy: ZipTruncate,
+			want:   []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "b")},
+		},
+		{
+			name:    "error",
+			a:       []int{1, 2, 3},
+			b:       []string{"a", "b"},
+			policy:  ZipError,
+			wantErr: errs.NewErrLengthMismatch(3, 2),
+		},
+		{
+			name:   "pad a",
+			a:      []int{1},
+			b:      []string{"a", "b"},
+			policy: ZipPad,
+			want:   []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(0, "b")},
+		},
+		{
+			name:   "pad b",
+			a:      []int{1, 2},
+			b:      []string{"a"},
+			policy: ZipPad,
+			want:   []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "")},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Zip(tc.a, tc.b, tc.policy)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	names := []string{"Tom", "Jerry", "Spike"}
+	ages := []int{18, 20}
+	res, err := ZipWith(names, ages, ZipTruncate, func(idx int, name string, age int) testutil.Person {
+		return testutil.Person{Name: name, Age: age}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []testutil.Person{{Name: "Tom", Age: 18}, {Name: "Jerry", Age: 20}}, res)
+
+	res, err = ZipWith(names, ages, ZipPad, func(idx int, name string, age int) testutil.Person {
+		return testutil.Person{Name: name, Age: age}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, testutil.Person{Name: "Spike"}, res[2])
+
+	_, err = ZipWith(names, ages, ZipError, func(idx int, name string, age int) testutil.Person {
+		return testutil.Person{Name: name, Age: age}
+	})
+	assert.Equal(t, errs.NewErrLengthMismatch(3, 2), err)
+}
+
+func TestUnzip(t *testing.T) {
+	as, bs := Unzip[int, string](nil)
+	assert.Equal(t, []int{}, as)
+	assert.Equal(t, []string{}, bs)
+
+	as, bs = Unzip([]tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "b")})
+	assert.Equal(t, []int{1, 2}, as)
+	assert.Equal(t, []string{"a", "b"}, bs)
+}
+
+func ExampleZip() {
+	res, _ := Zip([]int{1, 2, 3}, []string{"a", "b"}, ZipPad)
+	fmt.Println(res)
+	_, err := Zip([]int{1, 2, 3}, []string{"a", "b"}, ZipError)
+	fmt.Println(err)
+	// Output:
+	// [<1, "a"> <2, "b"> <3, "">]
+	// arktools: 长度不一致，左边 3, 右边 2
+}
+
+func ExampleUnzip() {
+	ids, names := Unzip([]tuple.Pair[int, string]{tuple.NewPair(1, "Tom"), tuple.NewPair(2, "Jerry")})
+	fmt.Println(ids, names)
+	// Output: [1 2] [Tom Jerry]
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tuple 提供泛型的二元组 Pair 和三元组 Triple
+package tuple
+
+import "fmt"
+
+// Pair 二元组，常用于表示键值对或者两个切片按位置配对的结果
+type Pair[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// NewPair 创建一个二元组
+func NewPair[K any, V any](key K, value V) Pair[K, V] {
+	return Pair[K, V]{Key: key, Value: value}
+}
+
+// String 返回 <Key, Value> 形式的字符串
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("<%#v, %#v>", p.Key, p.Value)
+}
+
+// Split 拆分二元组，返回 Key 和 Value
+func (p Pair[K, V]) Split() (K, V) {
+	return p.Key, p.Value
+}
+
+// FromMap 将 map 转化为二元组切片
+// 返回的切片的顺序和 map 的遍历顺序一致，也就是说顺序是不确定的
+// 即使传入的 map 为 nil，也保证返回的是一个空切片而不是 nil
+func FromMap[K comparable, V any](m map[K]V) []Pair[K, V] {
+	res := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		res = append(res, NewPair(k, v))
+	}
+	return res
+}
+
+// ToMap 将二元组切片转化为 map
+// 和 slice.ToMap 的语义保持一致：出现重复的 Key 时，后出现的 Value 会覆盖先出现的 Value
+// 即使传入的切片为 nil，也保证返回的 map 是一个空 map 而不是 nil
+func ToMap[K comparable, V any](pairs []Pair[K, V]) map[K]V {
+	res := make(map[K]V, len(pairs))
+	for _, p := range pairs {
+		res[p.Key] = p.Value
+	}
+	return res
+}
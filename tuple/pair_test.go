@@ -0,0 +1,97 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuple
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPair(t *testing.T) {
+	p := NewPair("a", 1)
+	assert.Equal(t, Pair[string, int]{Key: "a", Value: 1}, p)
+	assert.Equal(t, `<"a", 1>`, p.String())
+	k, v := p.Split()
+	assert.Equal(t, "a", k)
+	assert.Equal(t, 1, v)
+}
+
+func TestFromMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		m    map[string]int
+		want []Pair[string, int]
+	}{
+		{
+			name: "nil",
+			want: []Pair[string, int]{},
+		},
+		{
+			name: "values",
+			m:    map[string]int{"a": 1, "b": 2},
+			want: []Pair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := FromMap(tc.m)
+			sort.Slice(res, func(i, j int) bool {
+				return res[i].Key < res[j].Key
+			})
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestToMap(t *testing.T) {
+	testCases := []struct {
+		name  string
+		pairs []Pair[string, int]
+		want  map[string]int
+	}{
+		{
+			name: "nil",
+			want: map[string]int{},
+		},
+		{
+			name:  "values",
+			pairs: []Pair[string, int]{NewPair("a", 1), NewPair("b", 2)},
+			want:  map[string]int{"a": 1, "b": 2},
+		},
+		{
+			name:  "duplicate",
+			pairs: []Pair[string, int]{NewPair("a", 1), NewPair("a", 2)},
+			want:  map[string]int{"a": 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ToMap(tc.pairs))
+		})
+	}
+}
+
+func ExamplePair() {
+	p := NewPair("age", 18)
+	fmt.Println(p)
+	k, v := p.Split()
+	fmt.Println(k, v)
+	// Output:
+	// <"age", 18>
+	// age 18
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuple
+
+import "fmt"
+
+// Triple 三元组
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple 创建一个三元组
+func NewTriple[A any, B any, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// String 返回 <First, Second, Third> 形式的字符串
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("<%#v, %#v, %#v>", t.First, t.Second, t.Third)
+}
+
+// Split 拆分三元组，依次返回 First、Second 和 Third
+func (t Triple[A, B, C]) Split() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
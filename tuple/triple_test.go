@@ -0,0 +1,37 @@
+// Copyright 2026 hanleilei
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuple
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriple(t *testing.T) {
+	tr := NewTriple("a", 1, true)
+	assert.Equal(t, Triple[string, int, bool]{First: "a", Second: 1, Third: true}, tr)
+	assert.Equal(t, `<"a", 1, true>`, tr.String())
+	a, b, c := tr.Split()
+	assert.Equal(t, "a", a)
+	assert.Equal(t, 1, b)
+	assert.True(t, c)
+}
+
+func ExampleTriple() {
+	fmt.Println(NewTriple("Tom", 18, 1.75))
+	// Output: <"Tom", 18, 1.75>
+}